@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"rssreader/internal/infra/database"
+	"rssreader/internal/infra/database/migrate"
+)
+
+const migrateUsage = "usage: migrate up | down [steps] | status"
+
+// migrator is implemented by the PostgreSQL and SQLite migrators.
+type migrator interface {
+	Up(ctx context.Context) ([]migrate.Migration, error)
+	Down(ctx context.Context, steps int) ([]migrate.Migration, error)
+	Status(ctx context.Context) ([]migrate.Status, error)
+}
+
+// runMigrate implements the "migrate" subcommand against the database in DATABASE_URL.
+func runMigrate(args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	dsn := os.Getenv("DATABASE_URL")
+	driver, err := database.ResolveDriver(os.Getenv("STORAGE_DRIVER"), dsn)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	var migrator migrator
+	switch driver {
+	case database.DriverPostgres:
+		pool, err := connectPostgres(ctx, dsn)
+		if err != nil {
+			return err
+		}
+		defer pool.Close()
+		if migrator, err = migrate.New(pool); err != nil {
+			return err
+		}
+	case database.DriverSQLite:
+		if dsn == "" {
+			dsn = database.DefaultSQLitePath
+		}
+		db, err := database.OpenSQLite(ctx, dsn)
+		if err != nil {
+			return fmt.Errorf("connect database: %w", err)
+		}
+		defer db.Close()
+		if migrator, err = migrate.NewSQLite(db); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("the %s driver keeps no schema to migrate", driver)
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, migration := range applied {
+			fmt.Printf("applied %04d_%s\n", migration.Version, migration.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("database is up to date")
+		}
+		return err
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps <= 0 {
+				return fmt.Errorf("invalid steps %q", args[1])
+			}
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		for _, migration := range reverted {
+			fmt.Printf("reverted %04d_%s\n", migration.Version, migration.Name)
+		}
+		return err
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.Applied {
+				appliedAt = status.AppliedAt.Local().Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%04d\t%s\t%s\n", status.Version, status.Name, appliedAt)
+		}
+		return w.Flush()
+	default:
+		return errors.New(migrateUsage)
+	}
+}
@@ -0,0 +1,102 @@
+package feed
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Limits on the curated catalog entries.
+const (
+	MaxCatalogIDLength          = 50
+	MaxCatalogNameLength        = 100
+	MaxCatalogDescriptionLength = 500
+	// CatalogBrokenAfter is how many consecutive failed health checks flag a source as broken.
+	CatalogBrokenAfter = 2
+)
+
+var catalogCategoryID = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// CatalogCategory groups curated sources suggested to readers, such as "Notícias".
+type CatalogCategory struct {
+	// ID is a stable slug, such as "noticias".
+	ID          string
+	Name        string
+	Description string
+	// Sources lists the category's sources in the order they were added.
+	Sources []CatalogSource
+}
+
+// CatalogSource is a curated feed suggested to readers. The same URL may be listed
+// in several categories.
+type CatalogSource struct {
+	ID          int64
+	CategoryID  string
+	Name        string
+	URL         string
+	Description string
+	// Disabled sources are hidden from readers but kept so the seed does not re-add them.
+	Disabled bool
+	// CheckedAt is when the URL was last health-checked; CheckError describes the last
+	// failed check and CheckFailures counts consecutive failures.
+	CheckedAt     time.Time
+	CheckError    string
+	CheckFailures int
+	CreatedAt     time.Time
+}
+
+// Broken reports whether the source failed enough consecutive health checks to be
+// flagged to readers.
+func (s CatalogSource) Broken() bool {
+	return s.CheckFailures >= CatalogBrokenAfter
+}
+
+// NormalizeCatalogCategory trims the category fields and checks they are usable.
+func NormalizeCatalogCategory(category CatalogCategory) (CatalogCategory, error) {
+	category.ID = strings.TrimSpace(category.ID)
+	if !catalogCategoryID.MatchString(category.ID) || len(category.ID) > MaxCatalogIDLength {
+		return CatalogCategory{}, fmt.Errorf("%w: category id must be a lowercase slug of at most %d characters", ErrInvalidInput, MaxCatalogIDLength)
+	}
+
+	var err error
+	if category.Name, err = normalizeCatalogText("category name", category.Name, MaxCatalogNameLength, true); err != nil {
+		return CatalogCategory{}, err
+	}
+	if category.Description, err = normalizeCatalogText("category description", category.Description, MaxCatalogDescriptionLength, false); err != nil {
+		return CatalogCategory{}, err
+	}
+	return category, nil
+}
+
+// NormalizeCatalogSource trims the source fields and checks they are usable.
+func NormalizeCatalogSource(source CatalogSource) (CatalogSource, error) {
+	source.CategoryID = strings.TrimSpace(source.CategoryID)
+	if source.CategoryID == "" {
+		return CatalogSource{}, fmt.Errorf("%w: category id is required", ErrInvalidInput)
+	}
+
+	var err error
+	if source.URL, err = NormalizeURL(source.URL); err != nil {
+		return CatalogSource{}, err
+	}
+	if source.Name, err = normalizeCatalogText("source name", source.Name, MaxCatalogNameLength, true); err != nil {
+		return CatalogSource{}, err
+	}
+	if source.Description, err = normalizeCatalogText("source description", source.Description, MaxCatalogDescriptionLength, false); err != nil {
+		return CatalogSource{}, err
+	}
+	return source, nil
+}
+
+func normalizeCatalogText(field, raw string, limit int, required bool) (string, error) {
+	value := strings.TrimSpace(raw)
+	switch {
+	case required && value == "":
+		return "", fmt.Errorf("%w: %s is required", ErrInvalidInput, field)
+	case utf8.RuneCountInString(value) > limit:
+		return "", fmt.Errorf("%w: %s exceeds %d characters", ErrInvalidInput, field, limit)
+	}
+	return value, nil
+}
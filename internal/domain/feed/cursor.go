@@ -0,0 +1,56 @@
+package feed
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cursor marks a position in a listing ordered by a timestamp and an identifier,
+// both descending. Keyset pages resume strictly after it, so rows inserted while
+// paginating never shift or repeat entries.
+type Cursor struct {
+	Time time.Time
+	ID   int64
+}
+
+// IsZero reports whether the cursor points to the start of the listing.
+func (c Cursor) IsZero() bool {
+	return c.Time.IsZero() && c.ID == 0
+}
+
+// Encode returns the opaque token handed to API clients.
+func (c Cursor) Encode() string {
+	raw := strconv.FormatInt(c.Time.UnixNano(), 10) + ":" + strconv.FormatInt(c.ID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseCursor decodes a token produced by Cursor.Encode; an empty token yields the zero cursor.
+func ParseCursor(token string) (Cursor, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return Cursor{}, nil
+	}
+
+	invalid := fmt.Errorf("%w: invalid cursor", ErrInvalidInput)
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return Cursor{}, invalid
+	}
+	nanos, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return Cursor{}, invalid
+	}
+	unixNano, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return Cursor{}, invalid
+	}
+	cursorID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return Cursor{}, invalid
+	}
+
+	return Cursor{Time: time.Unix(0, unixNano).UTC(), ID: cursorID}, nil
+}
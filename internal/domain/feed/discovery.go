@@ -0,0 +1,16 @@
+package feed
+
+// Feed formats reported for discovered candidates.
+const (
+	FormatRSS  = "rss"
+	FormatAtom = "atom"
+	FormatJSON = "json"
+)
+
+// Candidate is a feed found while inspecting a web page.
+type Candidate struct {
+	URL   string
+	Title string
+	// Format is one of FormatRSS, FormatAtom or FormatJSON.
+	Format string
+}
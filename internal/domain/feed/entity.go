@@ -1,30 +1,129 @@
 package feed
 
-import "time"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
 
 // Feed represents the RSS feed metadata and entries.
 type Feed struct {
-	SourceURL   string
-	Title       string
-	Description string
-	Link        string
-	Items       []Item
-	FetchedAt   time.Time
+	SourceURL    string
+	Title        string
+	Description  string
+	Link         string
+	Image        string
+	Authors      []string
+	Categories   []string
+	UpdatedAt    time.Time
+	Items        []Item
+	FetchedAt    time.Time
+	ETag         string
+	LastModified string
+	CheckedAt    time.Time
+	// TTL is how long the snapshot may be served after CheckedAt without contacting
+	// the publisher again.
+	TTL time.Duration
+}
+
+// FreshAt reports whether the snapshot can still be served at now without revalidation.
+func (f *Feed) FreshAt(now time.Time) bool {
+	return f != nil && f.TTL > 0 && now.Before(f.CheckedAt.Add(f.TTL))
+}
+
+// AgeAt returns how long before now the publisher last confirmed the snapshot.
+func (f *Feed) AgeAt(now time.Time) time.Duration {
+	confirmed := f.CheckedAt
+	if confirmed.IsZero() {
+		confirmed = f.FetchedAt
+	}
+	if confirmed.IsZero() || now.Before(confirmed) {
+		return 0
+	}
+	return now.Sub(confirmed)
+}
+
+// Validators returns the HTTP cache validators captured on the last successful fetch.
+func (f *Feed) Validators() Validators {
+	if f == nil {
+		return Validators{}
+	}
+	return Validators{ETag: f.ETag, LastModified: f.LastModified}
+}
+
+// Validators are the HTTP cache validators sent on conditional requests.
+type Validators struct {
+	ETag         string
+	LastModified string
 }
 
 // Item represents a single entry in the RSS feed.
 type Item struct {
+	ID          int64
+	FeedURL     string
+	GUID        string
 	Title       string
 	Link        string
 	Description string
+	Content     string
+	Authors     []string
+	Categories  []string
+	Image       string
+	Enclosures  []Enclosure
 	PublishedAt time.Time
+	UpdatedAt   time.Time
+	Read        bool
+	Starred     bool
+	Archived    bool
+}
+
+// StateChange describes a partial update of the reading state of an item.
+// Nil fields are left untouched.
+type StateChange struct {
+	Read     *bool
+	Starred  *bool
+	Archived *bool
+}
+
+// IsEmpty reports whether the change would not modify anything.
+func (c StateChange) IsEmpty() bool {
+	return c.Read == nil && c.Starred == nil && c.Archived == nil
+}
+
+// Enclosure is a media attachment of an item, such as a podcast episode.
+type Enclosure struct {
+	URL    string
+	Type   string
+	Length int64
+}
+
+// Key returns the stable identity of the item within its feed: the GUID when
+// present, falling back to the link and finally to a hash of its content.
+func (i Item) Key() string {
+	switch {
+	case i.GUID != "":
+		return i.GUID
+	case i.Link != "":
+		return i.Link
+	default:
+		sum := sha256.Sum256([]byte(i.Title + "\n" + i.Description))
+		return "sha256:" + hex.EncodeToString(sum[:])
+	}
 }
 
 // Summary represents persisted metadata for a feed.
 type Summary struct {
+	ID          int64
 	SourceURL   string
 	Title       string
 	Description string
 	Link        string
 	FetchedAt   time.Time
+	UnreadCount int
+	// FolderID is the folder the feed is filed in, or zero when it is unfiled.
+	FolderID int64
+	Tags     []string
+	// Health is one of the Health constants, derived from the fetch log by the use
+	// cases; stores leave it empty.
+	Health string
 }
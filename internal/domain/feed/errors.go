@@ -0,0 +1,37 @@
+package feed
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Error categories shared by the use cases. Callers wrap them with context and
+// classify failures with errors.Is.
+var (
+	ErrInvalidInput        = errors.New("invalid input")
+	ErrNotFound            = errors.New("not found")
+	ErrConflict            = errors.New("already exists")
+	ErrUpstreamUnreachable = errors.New("upstream unreachable")
+	ErrUpstreamTimeout     = errors.New("upstream timed out")
+	// ErrUpstreamUnavailable means the fetcher is deliberately not contacting the
+	// publisher, e.g. while its circuit breaker is open.
+	ErrUpstreamUnavailable = errors.New("upstream temporarily unavailable")
+	ErrUpstreamStatus      = errors.New("upstream returned an error status")
+	ErrFeedTooLarge        = errors.New("feed too large")
+	ErrUnparseableFeed     = errors.New("unparseable feed")
+	ErrStorage             = errors.New("storage failure")
+)
+
+// UpstreamStatusError reports the non-success status code a publisher answered with.
+type UpstreamStatusError struct {
+	StatusCode int
+}
+
+func (e *UpstreamStatusError) Error() string {
+	return fmt.Sprintf("upstream returned status %d", e.StatusCode)
+}
+
+// Is makes errors.Is(err, ErrUpstreamStatus) match any status code.
+func (e *UpstreamStatusError) Is(target error) bool {
+	return target == ErrUpstreamStatus
+}
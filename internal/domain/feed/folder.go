@@ -0,0 +1,74 @@
+package feed
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Limits on the names users give folders and tags.
+const (
+	MaxFolderNameLength = 100
+	MaxTagLength        = 50
+	MaxFeedTags         = 20
+)
+
+// Folder groups stored feeds on the server; a feed is filed in at most one folder.
+type Folder struct {
+	ID        int64
+	Name      string
+	CreatedAt time.Time
+	// FeedCount and UnreadCount summarize the feeds filed in the folder.
+	FeedCount   int
+	UnreadCount int
+}
+
+// TagCount reports how many stored feeds carry a tag.
+type TagCount struct {
+	Tag       string
+	FeedCount int
+}
+
+// NormalizeFolderName trims the name and checks it is usable as a folder name.
+func NormalizeFolderName(raw string) (string, error) {
+	name := strings.TrimSpace(raw)
+	switch {
+	case name == "":
+		return "", fmt.Errorf("%w: folder name is required", ErrInvalidInput)
+	case utf8.RuneCountInString(name) > MaxFolderNameLength:
+		return "", fmt.Errorf("%w: folder name exceeds %d characters", ErrInvalidInput, MaxFolderNameLength)
+	}
+	return name, nil
+}
+
+// NormalizeTag trims and lowercases the tag so that "Go" and "go " are the same tag.
+func NormalizeTag(raw string) (string, error) {
+	tag := strings.ToLower(strings.TrimSpace(raw))
+	switch {
+	case tag == "":
+		return "", fmt.Errorf("%w: tag is required", ErrInvalidInput)
+	case utf8.RuneCountInString(tag) > MaxTagLength:
+		return "", fmt.Errorf("%w: tag %q exceeds %d characters", ErrInvalidInput, tag, MaxTagLength)
+	}
+	return tag, nil
+}
+
+// NormalizeTags normalizes every tag and returns them sorted without duplicates.
+func NormalizeTags(raw []string) ([]string, error) {
+	tags := make([]string, 0, len(raw))
+	for _, value := range raw {
+		tag, err := NormalizeTag(value)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	slices.Sort(tags)
+	tags = slices.Compact(tags)
+	if len(tags) > MaxFeedTags {
+		return nil, fmt.Errorf("%w: a feed takes at most %d tags", ErrInvalidInput, MaxFeedTags)
+	}
+	return tags, nil
+}
@@ -0,0 +1,115 @@
+package feed
+
+import (
+	"errors"
+	"time"
+)
+
+// Health states derived from the recent fetch attempts of a feed.
+const (
+	HealthHealthy  = "healthy"
+	HealthDegraded = "degraded"
+	HealthFailing  = "failing"
+)
+
+// Error classes recorded for failed fetch attempts.
+const (
+	FetchErrorInvalid     = "invalid"
+	FetchErrorTimeout     = "timeout"
+	FetchErrorUnavailable = "unavailable"
+	FetchErrorStatus      = "status"
+	FetchErrorTooLarge    = "too_large"
+	FetchErrorUnreachable = "unreachable"
+	FetchErrorUnparseable = "unparseable"
+	FetchErrorStorage     = "storage"
+	FetchErrorOther       = "other"
+)
+
+const (
+	// HealthWindow is how many of the latest attempts the health status considers.
+	HealthWindow = 10
+	// FailingAfter is the number of consecutive failed attempts that marks a feed failing.
+	FailingAfter = 3
+	// FetchLogRetention caps how many attempts are kept per feed URL.
+	FetchLogRetention = 100
+)
+
+// FetchAttempt records a single download of a feed, successful or not.
+type FetchAttempt struct {
+	ID          int64
+	SourceURL   string
+	AttemptedAt time.Time
+	// StatusCode is the publisher's HTTP status, or zero when no response arrived.
+	StatusCode int
+	Duration   time.Duration
+	Bytes      int64
+	// ErrorClass is one of the FetchError constants, or empty for a successful attempt.
+	ErrorClass string
+	Error      string
+	// ItemDelta counts the items the attempt found that the stored snapshot lacked.
+	ItemDelta int
+}
+
+// Failed reports whether the attempt did not produce a usable feed.
+func (a FetchAttempt) Failed() bool {
+	return a.ErrorClass != ""
+}
+
+// HealthOf derives the health status from attempts ordered newest first, looking at
+// the latest HealthWindow of them. A feed is failing after FailingAfter consecutive
+// failures and degraded while any recent attempt failed; without attempts the status
+// is unknown and empty.
+func HealthOf(attempts []FetchAttempt) string {
+	if len(attempts) == 0 {
+		return ""
+	}
+	if len(attempts) > HealthWindow {
+		attempts = attempts[:HealthWindow]
+	}
+
+	consecutive, failed := 0, 0
+	for i, attempt := range attempts {
+		if !attempt.Failed() {
+			continue
+		}
+		failed++
+		if consecutive == i {
+			consecutive++
+		}
+	}
+
+	switch {
+	case consecutive >= FailingAfter:
+		return HealthFailing
+	case failed > 0:
+		return HealthDegraded
+	default:
+		return HealthHealthy
+	}
+}
+
+// ClassifyFetchError returns the error class recorded for a failed fetch.
+func ClassifyFetchError(err error) string {
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, ErrInvalidInput):
+		return FetchErrorInvalid
+	case errors.Is(err, ErrUpstreamTimeout):
+		return FetchErrorTimeout
+	case errors.Is(err, ErrUpstreamUnavailable):
+		return FetchErrorUnavailable
+	case errors.Is(err, ErrUpstreamStatus):
+		return FetchErrorStatus
+	case errors.Is(err, ErrFeedTooLarge):
+		return FetchErrorTooLarge
+	case errors.Is(err, ErrUpstreamUnreachable):
+		return FetchErrorUnreachable
+	case errors.Is(err, ErrUnparseableFeed):
+		return FetchErrorUnparseable
+	case errors.Is(err, ErrStorage):
+		return FetchErrorStorage
+	default:
+		return FetchErrorOther
+	}
+}
@@ -0,0 +1,21 @@
+package feed
+
+import "time"
+
+// Circuit breaker states reported for publisher hosts.
+const (
+	HostStateClosed   = "closed"
+	HostStateOpen     = "open"
+	HostStateHalfOpen = "half-open"
+)
+
+// HostStatus describes how the fetcher currently treats a publisher host.
+type HostStatus struct {
+	Host                string
+	State               string
+	ConsecutiveFailures int
+	LastError           string
+	OpenedAt            time.Time
+	// RetryAt is when an open circuit lets the next probe request through.
+	RetryAt time.Time
+}
@@ -0,0 +1,119 @@
+package feed
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sort orders for stored feed listings.
+const (
+	// SortFetchedAt lists the most recently fetched feeds first.
+	SortFetchedAt = "fetched"
+	// SortTitle lists feeds alphabetically, ignoring case.
+	SortTitle = "title"
+	// SortUnread lists the feeds with the most unread items first.
+	SortUnread = "unread"
+)
+
+// RecentQuery selects a page of stored feed summaries.
+type RecentQuery struct {
+	// Sort is one of the Sort constants; empty means SortFetchedAt.
+	Sort string
+	// After resumes the listing after this position; it must use the same sort.
+	After SummaryCursor
+	// FolderID and Tag restrict the listing to the feeds filed in the folder or
+	// carrying the tag; zero values disable the filter.
+	FolderID int64
+	Tag      string
+	Limit    int
+}
+
+// SummaryCursor marks a position in a listing of stored feeds. Only the key of the
+// active sort is meaningful; the feed id breaks ties in the same direction.
+type SummaryCursor struct {
+	Sort        string
+	FetchedAt   time.Time
+	Title       string
+	UnreadCount int
+	ID          int64
+}
+
+// SummaryCursorFor returns the cursor positioned at summary for the given sort.
+func SummaryCursorFor(sort string, summary Summary) SummaryCursor {
+	cursor := SummaryCursor{Sort: sort, ID: summary.ID}
+	switch sort {
+	case SortTitle:
+		cursor.Title = summary.Title
+	case SortUnread:
+		cursor.UnreadCount = summary.UnreadCount
+	default:
+		cursor.Sort = SortFetchedAt
+		cursor.FetchedAt = summary.FetchedAt
+	}
+	return cursor
+}
+
+// IsZero reports whether the cursor points to the start of the listing.
+func (c SummaryCursor) IsZero() bool {
+	return c.ID == 0
+}
+
+// Encode returns the opaque token handed to API clients.
+func (c SummaryCursor) Encode() string {
+	var key string
+	switch c.Sort {
+	case SortTitle:
+		key = c.Title
+	case SortUnread:
+		key = strconv.Itoa(c.UnreadCount)
+	default:
+		key = strconv.FormatInt(c.FetchedAt.UnixNano(), 10)
+	}
+	raw := c.Sort + ":" + strconv.FormatInt(c.ID, 10) + ":" + key
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseSummaryCursor decodes a token produced by SummaryCursor.Encode; an empty token
+// yields the zero cursor.
+func ParseSummaryCursor(token string) (SummaryCursor, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return SummaryCursor{}, nil
+	}
+
+	invalid := fmt.Errorf("%w: invalid cursor", ErrInvalidInput)
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return SummaryCursor{}, invalid
+	}
+	parts := strings.SplitN(string(raw), ":", 3)
+	if len(parts) != 3 {
+		return SummaryCursor{}, invalid
+	}
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || id <= 0 {
+		return SummaryCursor{}, invalid
+	}
+
+	cursor := SummaryCursor{Sort: parts[0], ID: id}
+	switch cursor.Sort {
+	case SortTitle:
+		cursor.Title = parts[2]
+	case SortUnread:
+		if cursor.UnreadCount, err = strconv.Atoi(parts[2]); err != nil {
+			return SummaryCursor{}, invalid
+		}
+	case SortFetchedAt:
+		unixNano, err := strconv.ParseInt(parts[2], 10, 64)
+		if err != nil {
+			return SummaryCursor{}, invalid
+		}
+		cursor.FetchedAt = time.Unix(0, unixNano).UTC()
+	default:
+		return SummaryCursor{}, invalid
+	}
+	return cursor, nil
+}
@@ -0,0 +1,23 @@
+package feed
+
+import "time"
+
+// SearchQuery describes a full-text search over stored items.
+type SearchQuery struct {
+	Text    string
+	FeedURL string
+	From    time.Time
+	To      time.Time
+	Limit   int
+	Offset  int
+}
+
+// SearchHit is an item matching a search, with its relevance and highlighted excerpts.
+// Snippets are HTML in which the publisher's text is escaped and the only markup is the
+// <mark> tags wrapping matched terms.
+type SearchHit struct {
+	Item         Item
+	Rank         float64
+	TitleSnippet string
+	Snippet      string
+}
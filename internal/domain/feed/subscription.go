@@ -0,0 +1,44 @@
+package feed
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Subscription represents a feed URL that is refreshed periodically in the background.
+type Subscription struct {
+	ID           int64
+	SourceURL    string
+	Title        string
+	Folder       string
+	Interval     time.Duration
+	LastPolledAt time.Time
+	NextPollAt   time.Time
+	LastError    string
+	CreatedAt    time.Time
+}
+
+// Outline is a portable description of a subscribed feed, as exchanged with other readers.
+type Outline struct {
+	Title   string
+	FeedURL string
+	SiteURL string
+	Folder  string
+}
+
+// NormalizeURL trims the raw feed URL and checks it is an absolute http(s) URL.
+func NormalizeURL(raw string) (string, error) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		return "", fmt.Errorf("%w: url is required", ErrInvalidInput)
+	}
+
+	parsed, err := url.ParseRequestURI(trimmed)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return "", fmt.Errorf("%w: invalid feed url %q", ErrInvalidInput, trimmed)
+	}
+
+	return trimmed, nil
+}
@@ -0,0 +1,17 @@
+package feed
+
+import "time"
+
+// TimelineQuery selects items across stored feeds for the merged timeline, newest first.
+// Items sharing a link with an older matching item from another feed are left out.
+type TimelineQuery struct {
+	// FeedURLs restricts the timeline to these feeds; nil means every feed.
+	FeedURLs []string
+	Read     *bool
+	Starred  *bool
+	From     time.Time
+	To       time.Time
+	// After resumes the listing after the item at this (published at, id) position.
+	After Cursor
+	Limit int
+}
@@ -0,0 +1,61 @@
+// Package catalog embeds the curated catalog of Brazilian sources the server seeds on startup.
+package catalog
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+
+	"rssreader/internal/domain/feed"
+)
+
+//go:embed seed.json
+var seedJSON []byte
+
+type seedFile struct {
+	Categories []struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Sources     []struct {
+			Name        string `json:"name"`
+			URL         string `json:"url"`
+			Description string `json:"description"`
+		} `json:"sources"`
+	} `json:"categories"`
+}
+
+// Seed returns the embedded categories and sources, validated and in file order.
+func Seed() ([]feed.CatalogCategory, error) {
+	var file seedFile
+	if err := json.Unmarshal(seedJSON, &file); err != nil {
+		return nil, fmt.Errorf("decode catalog seed: %w", err)
+	}
+
+	categories := make([]feed.CatalogCategory, 0, len(file.Categories))
+	for _, entry := range file.Categories {
+		category, err := feed.NormalizeCatalogCategory(feed.CatalogCategory{
+			ID:          entry.ID,
+			Name:        entry.Name,
+			Description: entry.Description,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("catalog seed category %q: %w", entry.ID, err)
+		}
+
+		for _, raw := range entry.Sources {
+			source, err := feed.NormalizeCatalogSource(feed.CatalogSource{
+				CategoryID:  category.ID,
+				Name:        raw.Name,
+				URL:         raw.URL,
+				Description: raw.Description,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("catalog seed source %q: %w", raw.Name, err)
+			}
+			category.Sources = append(category.Sources, source)
+		}
+		categories = append(categories, category)
+	}
+	return categories, nil
+}
@@ -0,0 +1,36 @@
+package catalog_test
+
+import (
+	"testing"
+
+	"rssreader/internal/infra/catalog"
+)
+
+func TestSeedIsValid(t *testing.T) {
+	categories, err := catalog.Seed()
+	if err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+	if len(categories) == 0 {
+		t.Fatal("expected the embedded seed to list categories")
+	}
+
+	ids := make(map[string]bool)
+	for _, category := range categories {
+		if ids[category.ID] {
+			t.Errorf("duplicate category id %q", category.ID)
+		}
+		ids[category.ID] = true
+
+		if len(category.Sources) == 0 {
+			t.Errorf("category %q has no sources", category.ID)
+		}
+		urls := make(map[string]bool)
+		for _, source := range category.Sources {
+			if urls[source.URL] {
+				t.Errorf("category %q lists %s twice", category.ID, source.URL)
+			}
+			urls[source.URL] = true
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package migrate_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"rssreader/internal/infra/database"
+	"rssreader/internal/infra/database/migrate"
+)
+
+func TestLoadOrdersMigrationsByVersion(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0010_add_index.up.sql":      {Data: []byte("CREATE INDEX a ON t (x);")},
+		"0010_add_index.down.sql":    {Data: []byte("DROP INDEX a;")},
+		"0002_create_table.up.sql":   {Data: []byte("CREATE TABLE t (x INT);")},
+		"0002_create_table.down.sql": {Data: []byte("DROP TABLE t;")},
+		"README.md":                  {Data: []byte("ignored")},
+	}
+
+	migrations, err := migrate.Load(fsys)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(migrations) != 2 {
+		t.Fatalf("expected 2 migrations, got %d", len(migrations))
+	}
+	if migrations[0].Version != 2 || migrations[0].Name != "create_table" || migrations[1].Version != 10 {
+		t.Errorf("unexpected order: %+v", migrations)
+	}
+	if migrations[1].Down != "DROP INDEX a;" {
+		t.Errorf("expected down script to be loaded, got %q", migrations[1].Down)
+	}
+}
+
+func TestLoadRejectsMalformedFiles(t *testing.T) {
+	cases := map[string]fstest.MapFS{
+		"missing version":  {"create_table.up.sql": {Data: []byte("SELECT 1;")}},
+		"missing up":       {"0001_create_table.down.sql": {Data: []byte("SELECT 1;")}},
+		"unknown suffix":   {"0001_create_table.sql": {Data: []byte("SELECT 1;")}},
+		"conflicting name": {"0001_a.up.sql": {Data: []byte("SELECT 1;")}, "0001_b.down.sql": {Data: []byte("SELECT 1;")}},
+	}
+
+	for name, fsys := range cases {
+		if _, err := migrate.Load(fsys); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestShippedMigrationsAreSequential(t *testing.T) {
+	for _, dir := range []string{"postgres", "sqlite"} {
+		migrations, err := migrate.Load(os.DirFS(dir))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", dir, err)
+		}
+		for i, migration := range migrations {
+			if migration.Version != int64(i+1) {
+				t.Errorf("%s: expected version %d, got %d (%s)", dir, i+1, migration.Version, migration.Name)
+			}
+			if migration.Down == "" {
+				t.Errorf("%s: migration %d_%s has no down script", dir, migration.Version, migration.Name)
+			}
+		}
+	}
+}
+
+func TestSQLiteMigratorAppliesAndRevertsEveryMigration(t *testing.T) {
+	ctx := context.Background()
+	db, err := database.OpenSQLite(ctx, filepath.Join(t.TempDir(), "migrate.db"))
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	defer db.Close()
+
+	migrator, err := migrate.NewSQLite(db)
+	if err != nil {
+		t.Fatalf("new migrator: %v", err)
+	}
+	applied, err := migrator.Up(ctx)
+	if err != nil || len(applied) == 0 {
+		t.Fatalf("expected migrations to apply, got %d, %v", len(applied), err)
+	}
+	if again, err := migrator.Up(ctx); err != nil || len(again) != 0 {
+		t.Fatalf("expected an up to date database, got %d, %v", len(again), err)
+	}
+
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	for _, status := range statuses {
+		if !status.Applied || status.AppliedAt.IsZero() {
+			t.Errorf("expected %04d_%s to be applied, got %+v", status.Version, status.Name, status)
+		}
+	}
+
+	reverted, err := migrator.Down(ctx, len(applied))
+	if err != nil || len(reverted) != len(applied) {
+		t.Fatalf("expected every migration to revert, got %d, %v", len(reverted), err)
+	}
+	var tables int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name NOT IN ('schema_migrations', 'sqlite_sequence');`).Scan(&tables); err != nil {
+		t.Fatalf("count tables: %v", err)
+	}
+	if tables != 0 {
+		t.Errorf("expected reverting every migration to drop every table, %d left", tables)
+	}
+}
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS feeds (
+	id SERIAL PRIMARY KEY,
+	source_url TEXT UNIQUE NOT NULL,
+	title TEXT,
+	description TEXT,
+	link TEXT,
+	fetched_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
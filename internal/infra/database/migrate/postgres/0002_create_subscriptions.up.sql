@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS subscriptions (
+	id SERIAL PRIMARY KEY,
+	source_url TEXT UNIQUE NOT NULL,
+	interval_seconds INTEGER NOT NULL,
+	last_polled_at TIMESTAMPTZ,
+	next_poll_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	last_error TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS subscriptions_next_poll_at_idx ON subscriptions (next_poll_at);
@@ -0,0 +1,3 @@
+ALTER TABLE feeds DROP COLUMN IF EXISTS checked_at;
+ALTER TABLE feeds DROP COLUMN IF EXISTS last_modified;
+ALTER TABLE feeds DROP COLUMN IF EXISTS etag;
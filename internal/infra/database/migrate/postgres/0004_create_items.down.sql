@@ -0,0 +1,2 @@
+-- The legacy JSONB snapshot column is not restored.
+DROP TABLE IF EXISTS items;
@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS items (
+	id BIGSERIAL PRIMARY KEY,
+	feed_id INTEGER NOT NULL REFERENCES feeds (id) ON DELETE CASCADE,
+	item_key TEXT NOT NULL,
+	guid TEXT NOT NULL DEFAULT '',
+	title TEXT NOT NULL DEFAULT '',
+	link TEXT NOT NULL DEFAULT '',
+	description TEXT NOT NULL DEFAULT '',
+	published_at TIMESTAMPTZ NOT NULL,
+	first_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	last_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	UNIQUE (feed_id, item_key)
+);
+CREATE INDEX IF NOT EXISTS items_feed_published_idx ON items (feed_id, published_at DESC, id DESC);
+
+-- Move items from the legacy JSONB snapshot column into the items table.
+DO $$
+BEGIN
+	IF EXISTS (
+		SELECT 1 FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = 'feeds' AND column_name = 'items'
+	) THEN
+		INSERT INTO items (feed_id, item_key, title, link, description, published_at, first_seen_at, last_seen_at)
+		SELECT f.id,
+		       COALESCE(
+		           NULLIF(e->>'Link', ''),
+		           'sha256:' || encode(sha256(convert_to(COALESCE(e->>'Title', '') || E'\n' || COALESCE(e->>'Description', ''), 'UTF8')), 'hex')
+		       ),
+		       COALESCE(e->>'Title', ''),
+		       COALESCE(e->>'Link', ''),
+		       COALESCE(e->>'Description', ''),
+		       COALESCE((e->>'PublishedAt')::timestamptz, f.fetched_at),
+		       f.fetched_at,
+		       f.fetched_at
+		FROM feeds f, jsonb_array_elements(f.items) e
+		ON CONFLICT (feed_id, item_key) DO NOTHING;
+
+		ALTER TABLE feeds DROP COLUMN items;
+	END IF;
+END $$;
@@ -0,0 +1,10 @@
+ALTER TABLE items DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE items DROP COLUMN IF EXISTS enclosures;
+ALTER TABLE items DROP COLUMN IF EXISTS image_url;
+ALTER TABLE items DROP COLUMN IF EXISTS categories;
+ALTER TABLE items DROP COLUMN IF EXISTS authors;
+ALTER TABLE items DROP COLUMN IF EXISTS content;
+ALTER TABLE feeds DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE feeds DROP COLUMN IF EXISTS categories;
+ALTER TABLE feeds DROP COLUMN IF EXISTS authors;
+ALTER TABLE feeds DROP COLUMN IF EXISTS image_url;
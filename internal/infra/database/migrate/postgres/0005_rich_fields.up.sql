@@ -0,0 +1,10 @@
+ALTER TABLE feeds ADD COLUMN IF NOT EXISTS image_url TEXT NOT NULL DEFAULT '';
+ALTER TABLE feeds ADD COLUMN IF NOT EXISTS authors TEXT[];
+ALTER TABLE feeds ADD COLUMN IF NOT EXISTS categories TEXT[];
+ALTER TABLE feeds ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ;
+ALTER TABLE items ADD COLUMN IF NOT EXISTS content TEXT NOT NULL DEFAULT '';
+ALTER TABLE items ADD COLUMN IF NOT EXISTS authors TEXT[];
+ALTER TABLE items ADD COLUMN IF NOT EXISTS categories TEXT[];
+ALTER TABLE items ADD COLUMN IF NOT EXISTS image_url TEXT NOT NULL DEFAULT '';
+ALTER TABLE items ADD COLUMN IF NOT EXISTS enclosures JSONB;
+ALTER TABLE items ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ;
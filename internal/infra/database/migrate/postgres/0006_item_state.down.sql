@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS items_unread_idx;
+ALTER TABLE items DROP COLUMN IF EXISTS archived;
+ALTER TABLE items DROP COLUMN IF EXISTS starred;
+ALTER TABLE items DROP COLUMN IF EXISTS read;
@@ -0,0 +1,2 @@
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS folder;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS title;
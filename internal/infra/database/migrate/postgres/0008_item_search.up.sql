@@ -0,0 +1,6 @@
+ALTER TABLE items ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+	setweight(to_tsvector('portuguese', title), 'A') ||
+	setweight(to_tsvector('portuguese', description), 'B') ||
+	setweight(to_tsvector('portuguese', content), 'C')
+) STORED;
+CREATE INDEX IF NOT EXISTS items_search_idx ON items USING GIN (search_vector);
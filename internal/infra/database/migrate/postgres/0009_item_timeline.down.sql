@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS items_link_idx;
+DROP INDEX IF EXISTS items_timeline_idx;
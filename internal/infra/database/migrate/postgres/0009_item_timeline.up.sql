@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS items_timeline_idx ON items (published_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS items_link_idx ON items (link) WHERE link <> '';
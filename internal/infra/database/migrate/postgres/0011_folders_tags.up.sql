@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS folders (
+	id BIGSERIAL PRIMARY KEY,
+	name TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS folders_name_idx ON folders (lower(name));
+
+ALTER TABLE feeds ADD COLUMN IF NOT EXISTS folder_id BIGINT REFERENCES folders (id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS feeds_folder_idx ON feeds (folder_id);
+
+CREATE TABLE IF NOT EXISTS feed_tags (
+	feed_id INTEGER NOT NULL REFERENCES feeds (id) ON DELETE CASCADE,
+	tag TEXT NOT NULL,
+	PRIMARY KEY (feed_id, tag)
+);
+CREATE INDEX IF NOT EXISTS feed_tags_tag_idx ON feed_tags (tag);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS fetch_log (
+	id BIGSERIAL PRIMARY KEY,
+	source_url TEXT NOT NULL,
+	attempted_at TIMESTAMPTZ NOT NULL,
+	status_code INTEGER NOT NULL DEFAULT 0,
+	duration_ms BIGINT NOT NULL DEFAULT 0,
+	bytes BIGINT NOT NULL DEFAULT 0,
+	error_class TEXT NOT NULL DEFAULT '',
+	error TEXT NOT NULL DEFAULT '',
+	item_delta INTEGER NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS fetch_log_source_url_idx ON fetch_log (source_url, id);
@@ -0,0 +1,180 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"strings"
+	"time"
+)
+
+//go:embed sqlite/*.sql
+var sqliteFiles embed.FS
+
+// sqliteTimeLayout matches the fixed-width UTC text the SQLite stores use for timestamps.
+const sqliteTimeLayout = "2006-01-02T15:04:05.000000000Z"
+
+// sqliteAdopted detects the migrations a database created before SQLite migrations were
+// versioned already carries; they are recorded without running. Migrations that only
+// create tables use IF NOT EXISTS and need no entry.
+var sqliteAdopted = map[int64]string{
+	2: `SELECT COUNT(*) FROM pragma_table_info('feeds') WHERE name = 'ttl_seconds';`,
+	3: `SELECT COUNT(*) FROM pragma_table_info('feeds') WHERE name = 'folder_id';`,
+}
+
+// SQLiteMigrator runs migrations against a SQLite database.
+type SQLiteMigrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// NewSQLite creates a SQLiteMigrator for the migrations embedded in the binary.
+func NewSQLite(db *sql.DB) (*SQLiteMigrator, error) {
+	if db == nil {
+		return nil, fmt.Errorf("db is required")
+	}
+
+	sub, err := fs.Sub(sqliteFiles, "sqlite")
+	if err != nil {
+		return nil, err
+	}
+	migrations, err := Load(sub)
+	if err != nil {
+		return nil, err
+	}
+	return &SQLiteMigrator{db: db, migrations: migrations}, nil
+}
+
+// Up applies every pending migration in order and returns the ones it applied.
+func (m *SQLiteMigrator) Up(ctx context.Context) ([]Migration, error) {
+	done, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	for _, migration := range m.migrations {
+		if _, ok := done[migration.Version]; ok {
+			continue
+		}
+		err := m.run(ctx, migration, migration.Up, sqliteAdopted[migration.Version],
+			`INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?);`,
+			migration.Version, migration.Name, time.Now().UTC().Format(sqliteTimeLayout))
+		if err != nil {
+			return applied, err
+		}
+		applied = append(applied, migration)
+	}
+	return applied, nil
+}
+
+// Down reverts the given number of most recently applied migrations and returns the ones it reverted.
+func (m *SQLiteMigrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	if steps <= 0 {
+		steps = 1
+	}
+
+	done, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var reverted []Migration
+	for i := len(m.migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+		migration := m.migrations[i]
+		if _, ok := done[migration.Version]; !ok {
+			continue
+		}
+		if strings.TrimSpace(migration.Down) == "" {
+			return reverted, fmt.Errorf("migration %d_%s cannot be reverted", migration.Version, migration.Name)
+		}
+		if err := m.run(ctx, migration, migration.Down, "",
+			`DELETE FROM schema_migrations WHERE version = ?;`, migration.Version); err != nil {
+			return reverted, err
+		}
+		reverted = append(reverted, migration)
+	}
+	return reverted, nil
+}
+
+// Status lists every known migration along with when it was applied.
+func (m *SQLiteMigrator) Status(ctx context.Context) ([]Status, error) {
+	done, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		appliedAt, ok := done[migration.Version]
+		statuses = append(statuses, Status{Migration: migration, Applied: ok, AppliedAt: appliedAt})
+	}
+	return statuses, nil
+}
+
+// applied creates the bookkeeping table when missing and lists the applied versions.
+func (m *SQLiteMigrator) applied(ctx context.Context) (map[int64]time.Time, error) {
+	const ddl = `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+	version INTEGER PRIMARY KEY,
+	name TEXT NOT NULL,
+	applied_at TEXT NOT NULL
+);
+`
+	if _, err := m.db.ExecContext(ctx, ddl); err != nil {
+		return nil, fmt.Errorf("create schema_migrations: %w", err)
+	}
+
+	rows, err := m.db.QueryContext(ctx, `SELECT version, applied_at FROM schema_migrations;`)
+	if err != nil {
+		return nil, fmt.Errorf("list applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int64]time.Time)
+	for rows.Next() {
+		var (
+			version   int64
+			appliedAt string
+		)
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, fmt.Errorf("scan applied migration: %w", err)
+		}
+		applied[version], _ = time.Parse(sqliteTimeLayout, appliedAt)
+	}
+	return applied, rows.Err()
+}
+
+// run executes the script and the bookkeeping statement in a single transaction. When
+// the adopted query counts a match the change is already present and only recorded.
+func (m *SQLiteMigrator) run(ctx context.Context, migration Migration, script, adopted, record string, args ...any) error {
+	err := func() error {
+		tx, err := m.db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback()
+
+		present := 0
+		if adopted != "" {
+			if err := tx.QueryRowContext(ctx, adopted).Scan(&present); err != nil {
+				return err
+			}
+		}
+		if present == 0 {
+			if _, err := tx.ExecContext(ctx, script); err != nil {
+				return err
+			}
+		}
+		if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+			return err
+		}
+		return tx.Commit()
+	}()
+	if err != nil {
+		return fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS subscriptions;
+DROP TABLE IF EXISTS items;
+DROP TABLE IF EXISTS feeds;
@@ -0,0 +1,56 @@
+CREATE TABLE IF NOT EXISTS feeds (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	source_url TEXT UNIQUE NOT NULL,
+	title TEXT NOT NULL DEFAULT '',
+	description TEXT NOT NULL DEFAULT '',
+	link TEXT NOT NULL DEFAULT '',
+	image_url TEXT NOT NULL DEFAULT '',
+	authors TEXT,
+	categories TEXT,
+	updated_at TEXT,
+	fetched_at TEXT NOT NULL,
+	etag TEXT NOT NULL DEFAULT '',
+	last_modified TEXT NOT NULL DEFAULT '',
+	checked_at TEXT
+);
+CREATE INDEX IF NOT EXISTS feeds_fetched_at_idx ON feeds (fetched_at DESC);
+
+CREATE TABLE IF NOT EXISTS items (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	feed_id INTEGER NOT NULL REFERENCES feeds (id) ON DELETE CASCADE,
+	item_key TEXT NOT NULL,
+	guid TEXT NOT NULL DEFAULT '',
+	title TEXT NOT NULL DEFAULT '',
+	link TEXT NOT NULL DEFAULT '',
+	description TEXT NOT NULL DEFAULT '',
+	content TEXT NOT NULL DEFAULT '',
+	authors TEXT,
+	categories TEXT,
+	image_url TEXT NOT NULL DEFAULT '',
+	enclosures TEXT,
+	published_at TEXT NOT NULL,
+	updated_at TEXT,
+	first_seen_at TEXT NOT NULL,
+	last_seen_at TEXT NOT NULL,
+	read INTEGER NOT NULL DEFAULT 0,
+	starred INTEGER NOT NULL DEFAULT 0,
+	archived INTEGER NOT NULL DEFAULT 0,
+	UNIQUE (feed_id, item_key)
+);
+CREATE INDEX IF NOT EXISTS items_feed_published_idx ON items (feed_id, published_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS items_unread_idx ON items (feed_id) WHERE NOT read AND NOT archived;
+CREATE INDEX IF NOT EXISTS items_timeline_idx ON items (published_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS items_link_idx ON items (link) WHERE link <> '';
+
+CREATE TABLE IF NOT EXISTS subscriptions (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	source_url TEXT UNIQUE NOT NULL,
+	title TEXT NOT NULL DEFAULT '',
+	folder TEXT NOT NULL DEFAULT '',
+	interval_seconds INTEGER NOT NULL,
+	last_polled_at TEXT,
+	next_poll_at TEXT NOT NULL,
+	last_error TEXT NOT NULL DEFAULT '',
+	created_at TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS subscriptions_next_poll_at_idx ON subscriptions (next_poll_at);
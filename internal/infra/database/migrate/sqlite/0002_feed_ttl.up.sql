@@ -0,0 +1 @@
+ALTER TABLE feeds ADD COLUMN ttl_seconds INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS feed_tags;
+DROP INDEX IF EXISTS feeds_folder_idx;
+ALTER TABLE feeds DROP COLUMN folder_id;
+DROP TABLE IF EXISTS folders;
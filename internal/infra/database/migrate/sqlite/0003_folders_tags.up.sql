@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS folders (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	name TEXT NOT NULL,
+	created_at TEXT NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS folders_name_idx ON folders (lower(name));
+
+ALTER TABLE feeds ADD COLUMN folder_id INTEGER REFERENCES folders (id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS feeds_folder_idx ON feeds (folder_id);
+
+CREATE TABLE IF NOT EXISTS feed_tags (
+	feed_id INTEGER NOT NULL REFERENCES feeds (id) ON DELETE CASCADE,
+	tag TEXT NOT NULL,
+	PRIMARY KEY (feed_id, tag)
+);
+CREATE INDEX IF NOT EXISTS feed_tags_tag_idx ON feed_tags (tag);
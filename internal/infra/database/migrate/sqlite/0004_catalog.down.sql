@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS catalog_sources;
+DROP TABLE IF EXISTS catalog_categories;
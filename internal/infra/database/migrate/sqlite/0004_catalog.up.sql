@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS catalog_categories (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	description TEXT NOT NULL DEFAULT '',
+	position INTEGER NOT NULL,
+	created_at TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS catalog_sources (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	category_id TEXT NOT NULL REFERENCES catalog_categories (id) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	url TEXT NOT NULL,
+	description TEXT NOT NULL DEFAULT '',
+	disabled INTEGER NOT NULL DEFAULT 0,
+	checked_at TEXT,
+	check_error TEXT NOT NULL DEFAULT '',
+	check_failures INTEGER NOT NULL DEFAULT 0,
+	created_at TEXT NOT NULL,
+	UNIQUE (category_id, url)
+);
+CREATE INDEX IF NOT EXISTS catalog_sources_url_idx ON catalog_sources (url);
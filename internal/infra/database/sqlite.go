@@ -0,0 +1,97 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strings"
+
+	// Registers the pure-Go "sqlite" driver.
+	_ "modernc.org/sqlite"
+)
+
+// Supported storage drivers.
+const (
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+	DriverMemory   = "memory"
+)
+
+// DefaultSQLitePath is the database file used when the sqlite driver is selected without a DSN.
+const DefaultSQLitePath = "rssreader.db"
+
+// ResolveDriver picks the storage driver from an explicit name or, when empty, from the DSN scheme.
+func ResolveDriver(name, dsn string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case DriverPostgres, "postgresql", "pgx":
+		return DriverPostgres, nil
+	case DriverSQLite, "sqlite3":
+		return DriverSQLite, nil
+	case DriverMemory:
+		return DriverMemory, nil
+	case "":
+	default:
+		return "", fmt.Errorf("unsupported storage driver %q", name)
+	}
+
+	scheme, _, found := strings.Cut(dsn, ":")
+	if !found {
+		return DriverPostgres, nil
+	}
+	switch strings.ToLower(scheme) {
+	case "sqlite", "sqlite3", "file":
+		return DriverSQLite, nil
+	case "memory":
+		return DriverMemory, nil
+	default:
+		return DriverPostgres, nil
+	}
+}
+
+// OpenSQLite opens the SQLite database described by dsn, which may be a plain path,
+// a file: URI or a sqlite:// URL, with foreign keys and WAL enabled.
+func OpenSQLite(ctx context.Context, dsn string) (*sql.DB, error) {
+	path, query := sqlitePath(dsn)
+	if path == "" {
+		return nil, fmt.Errorf("database dsn is required")
+	}
+
+	params, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("parse dsn: %w", err)
+	}
+	params.Add("_pragma", "foreign_keys(1)")
+	params.Add("_pragma", "busy_timeout(5000)")
+	if path != ":memory:" {
+		params.Add("_pragma", "journal_mode(WAL)")
+	}
+
+	db, err := sql.Open("sqlite", path+"?"+params.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("open database: %w", err)
+	}
+
+	// SQLite serialises writers; a single connection avoids SQLITE_BUSY between
+	// goroutines and keeps in-memory databases alive.
+	db.SetMaxOpenConns(1)
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("connect database: %w", err)
+	}
+
+	return db, nil
+}
+
+func sqlitePath(dsn string) (path, query string) {
+	dsn = strings.TrimSpace(dsn)
+	for _, prefix := range []string{"sqlite3://", "sqlite://", "sqlite3:", "sqlite:"} {
+		if len(dsn) >= len(prefix) && strings.EqualFold(dsn[:len(prefix)], prefix) {
+			dsn = dsn[len(prefix):]
+			break
+		}
+	}
+	path, query, _ = strings.Cut(dsn, "?")
+	return path, query
+}
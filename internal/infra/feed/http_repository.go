@@ -2,21 +2,83 @@ package feed
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
 
+	"rssreader/internal/domain/feed"
 	"rssreader/internal/infra/httpclient"
+	"rssreader/internal/repository"
 )
 
 // HTTPRepository downloads raw feed content via HTTP.
 type HTTPRepository struct {
-	client httpclient.Client
+	client      httpclient.Client
+	maxBodySize int64
 }
 
-// NewHTTPRepository wires a new HTTPRepository instance.
-func NewHTTPRepository(client httpclient.Client) *HTTPRepository {
-	return &HTTPRepository{client: client}
+// NewHTTPRepository wires a new HTTPRepository instance. Feeds larger than maxBodySize
+// bytes are rejected; zero selects httpclient.DefaultMaxBodySize.
+func NewHTTPRepository(client httpclient.Client, maxBodySize int64) *HTTPRepository {
+	return &HTTPRepository{client: client, maxBodySize: maxBodySize}
 }
 
-// Fetch retrieves the feed bytes from the given URL.
-func (r *HTTPRepository) Fetch(ctx context.Context, url string) ([]byte, error) {
-	return httpclient.FetchBytes(ctx, r.client, url)
+// Fetch opens the feed body from the given URL using a conditional GET. Errors, including
+// those returned while reading the body, wrap one of the feed.ErrUpstream* categories.
+func (r *HTTPRepository) Fetch(ctx context.Context, url string, validators feed.Validators) (*repository.FetchResult, error) {
+	res, err := httpclient.FetchConditional(ctx, r.client, url, validators.ETag, validators.LastModified, r.maxBodySize)
+	if err != nil {
+		return nil, classifyFetchError(err)
+	}
+
+	result := &repository.FetchResult{
+		StatusCode:   res.StatusCode,
+		ETag:         res.ETag,
+		LastModified: res.LastModified,
+		NotModified:  res.NotModified,
+		MaxAge:       res.MaxAge,
+		HasMaxAge:    res.HasMaxAge,
+	}
+	if res.Body != nil {
+		result.Body = classifiedBody{res.Body}
+	}
+	return result, nil
+}
+
+// classifiedBody classifies read errors so a dropped or oversized stream reports
+// the same categories as a failed request.
+type classifiedBody struct {
+	io.ReadCloser
+}
+
+func (b classifiedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && err != io.EOF {
+		err = classifyFetchError(err)
+	}
+	return n, err
+}
+
+func classifyFetchError(err error) error {
+	var (
+		statusErr *httpclient.StatusError
+		tooLarge  *httpclient.BodyTooLargeError
+		netErr    net.Error
+		wrap      = func(category error) error { return fmt.Errorf("%w: %w", category, err) }
+	)
+	switch {
+	case errors.Is(err, httpclient.ErrBlockedDestination):
+		return wrap(feed.ErrInvalidInput)
+	case errors.As(err, &statusErr):
+		return &feed.UpstreamStatusError{StatusCode: statusErr.StatusCode}
+	case errors.As(err, &tooLarge):
+		return wrap(feed.ErrFeedTooLarge)
+	case errors.Is(err, httpclient.ErrCircuitOpen):
+		return wrap(feed.ErrUpstreamUnavailable)
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return wrap(feed.ErrUpstreamTimeout)
+	default:
+		return wrap(feed.ErrUpstreamUnreachable)
+	}
 }
@@ -0,0 +1,50 @@
+package feed_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+	feedRepo "rssreader/internal/infra/feed"
+)
+
+func TestHTTPRepositoryClassifiesErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/missing":
+			http.NotFound(w, r)
+		case "/large":
+			w.(http.Flusher).Flush()
+			io.WriteString(w, strings.Repeat("x", 64))
+		}
+	}))
+	defer server.Close()
+
+	repo := feedRepo.NewHTTPRepository(server.Client(), 32)
+
+	_, err := repo.Fetch(context.Background(), server.URL+"/missing", feed.Validators{})
+	var statusErr *feed.UpstreamStatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusNotFound || !errors.Is(err, feed.ErrUpstreamStatus) {
+		t.Fatalf("expected upstream status 404, got %v", err)
+	}
+
+	res, err := repo.Fetch(context.Background(), server.URL+"/large", feed.Validators{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, err = io.ReadAll(res.Body)
+	res.Body.Close()
+	if !errors.Is(err, feed.ErrFeedTooLarge) {
+		t.Fatalf("expected feed too large while reading, got %v", err)
+	}
+
+	server.Close()
+	if _, err := repo.Fetch(context.Background(), server.URL+"/missing", feed.Validators{}); !errors.Is(err, feed.ErrUpstreamUnreachable) {
+		t.Fatalf("expected upstream unreachable, got %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+// MemoryCatalogStore keeps the curated catalog in process memory and is safe for concurrent use.
+type MemoryCatalogStore struct {
+	mu           sync.Mutex
+	categories   []feed.CatalogCategory
+	sources      []feed.CatalogSource
+	lastSourceID int64
+}
+
+// NewMemoryCatalogStore creates an empty in-memory CatalogStore.
+func NewMemoryCatalogStore() *MemoryCatalogStore {
+	return &MemoryCatalogStore{}
+}
+
+// SeedCatalog adds the categories and sources that are not stored yet.
+func (s *MemoryCatalogStore) SeedCatalog(ctx context.Context, categories []feed.CatalogCategory) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, category := range categories {
+		if s.categoryIndex(category.ID) < 0 {
+			s.addCategory(category)
+		}
+		for _, source := range category.Sources {
+			source.CategoryID = category.ID
+			if s.sourceIndex(source.CategoryID, source.URL) < 0 {
+				s.addSource(source)
+			}
+		}
+	}
+	return nil
+}
+
+// ListCatalog returns the categories in the order they were added, each with its sources.
+func (s *MemoryCatalogStore) ListCatalog(ctx context.Context, includeDisabled bool) ([]feed.CatalogCategory, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	categories := make([]feed.CatalogCategory, 0, len(s.categories))
+	for _, category := range s.categories {
+		category.Sources = []feed.CatalogSource{}
+		for _, source := range s.sources {
+			if source.CategoryID == category.ID && (includeDisabled || !source.Disabled) {
+				category.Sources = append(category.Sources, source)
+			}
+		}
+		categories = append(categories, category)
+	}
+	return categories, nil
+}
+
+// AddCatalogCategory stores a new category.
+func (s *MemoryCatalogStore) AddCatalogCategory(ctx context.Context, category feed.CatalogCategory) (*feed.CatalogCategory, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.categoryIndex(category.ID) >= 0 {
+		return nil, fmt.Errorf("add catalog category: %w", feed.ErrConflict)
+	}
+	added := s.addCategory(category)
+	return &added, nil
+}
+
+// AddCatalogSource stores a new source, or returns nil when its category does not exist.
+func (s *MemoryCatalogStore) AddCatalogSource(ctx context.Context, source feed.CatalogSource) (*feed.CatalogSource, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.categoryIndex(source.CategoryID) < 0 {
+		return nil, nil
+	}
+	if s.sourceIndex(source.CategoryID, source.URL) >= 0 {
+		return nil, fmt.Errorf("add catalog source: %w", feed.ErrConflict)
+	}
+	added := s.addSource(source)
+	return &added, nil
+}
+
+// SetCatalogSourceDisabled hides or restores the source, or returns nil when it does not exist.
+func (s *MemoryCatalogStore) SetCatalogSourceDisabled(ctx context.Context, id int64, disabled bool) (*feed.CatalogSource, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.sources {
+		if s.sources[i].ID == id {
+			s.sources[i].Disabled = disabled
+			updated := s.sources[i]
+			return &updated, nil
+		}
+	}
+	return nil, nil
+}
+
+// CatalogURLs returns the distinct URLs of the enabled sources.
+func (s *MemoryCatalogStore) CatalogURLs(ctx context.Context) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	urls := make([]string, 0, len(s.sources))
+	for _, source := range s.sources {
+		if !source.Disabled {
+			urls = append(urls, source.URL)
+		}
+	}
+	slices.Sort(urls)
+	return slices.Compact(urls), nil
+}
+
+// RecordCatalogCheck stores the outcome of a health check on every source with the URL.
+func (s *MemoryCatalogStore) RecordCatalogCheck(ctx context.Context, url string, checkedAt time.Time, checkErr string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.sources {
+		source := &s.sources[i]
+		if source.URL != url {
+			continue
+		}
+		source.CheckedAt = checkedAt.UTC()
+		source.CheckError = checkErr
+		if checkErr == "" {
+			source.CheckFailures = 0
+		} else {
+			source.CheckFailures++
+		}
+	}
+	return nil
+}
+
+func (s *MemoryCatalogStore) addCategory(category feed.CatalogCategory) feed.CatalogCategory {
+	category.Sources = nil
+	s.categories = append(s.categories, category)
+	return category
+}
+
+func (s *MemoryCatalogStore) addSource(source feed.CatalogSource) feed.CatalogSource {
+	s.lastSourceID++
+	source.ID = s.lastSourceID
+	source.Disabled = false
+	source.CheckedAt, source.CheckError, source.CheckFailures = time.Time{}, "", 0
+	source.CreatedAt = time.Now().UTC()
+	s.sources = append(s.sources, source)
+	return source
+}
+
+func (s *MemoryCatalogStore) categoryIndex(id string) int {
+	return slices.IndexFunc(s.categories, func(category feed.CatalogCategory) bool { return category.ID == id })
+}
+
+func (s *MemoryCatalogStore) sourceIndex(categoryID, url string) int {
+	return slices.IndexFunc(s.sources, func(source feed.CatalogSource) bool {
+		return source.CategoryID == categoryID && source.URL == url
+	})
+}
@@ -0,0 +1,68 @@
+package feed
+
+import (
+	"context"
+	"sync"
+
+	"rssreader/internal/domain/feed"
+)
+
+// MemoryFetchLogStore keeps fetch attempts in process memory and is safe for concurrent use.
+type MemoryFetchLogStore struct {
+	mu       sync.Mutex
+	attempts map[string][]feed.FetchAttempt
+	lastID   int64
+}
+
+// NewMemoryFetchLogStore creates an empty in-memory FetchLogStore.
+func NewMemoryFetchLogStore() *MemoryFetchLogStore {
+	return &MemoryFetchLogStore{attempts: make(map[string][]feed.FetchAttempt)}
+}
+
+// RecordFetch appends the attempt, keeping at most feed.FetchLogRetention per URL.
+func (s *MemoryFetchLogStore) RecordFetch(ctx context.Context, attempt feed.FetchAttempt) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastID++
+	attempt.ID = s.lastID
+	attempts := append(s.attempts[attempt.SourceURL], attempt)
+	if len(attempts) > feed.FetchLogRetention {
+		attempts = append([]feed.FetchAttempt(nil), attempts[len(attempts)-feed.FetchLogRetention:]...)
+	}
+	s.attempts[attempt.SourceURL] = attempts
+	return nil
+}
+
+// FetchHistory returns up to limit attempts of each URL, newest first.
+func (s *MemoryFetchLogStore) FetchHistory(ctx context.Context, urls []string, limit int) (map[string][]feed.FetchAttempt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	history := make(map[string][]feed.FetchAttempt)
+	for _, url := range urls {
+		stored := s.attempts[url]
+		for i := len(stored) - 1; i >= 0 && len(history[url]) < limit; i-- {
+			history[url] = append(history[url], stored[i])
+		}
+	}
+	return history, nil
+}
+
+// DeleteFetchHistory removes every attempt of the URL.
+func (s *MemoryFetchLogStore) DeleteFetchHistory(ctx context.Context, url string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.attempts, url)
+	return nil
+}
+
+// ClearFetchHistory removes every attempt.
+func (s *MemoryFetchLogStore) ClearFetchHistory(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.attempts = make(map[string][]feed.FetchAttempt)
+	return nil
+}
@@ -0,0 +1,194 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+// CreateFolder stores a new empty folder.
+func (s *MemoryStore) CreateFolder(ctx context.Context, name string) (*feed.Folder, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.folderNamed(name, 0) {
+		return nil, fmt.Errorf("create folder: %w", feed.ErrConflict)
+	}
+
+	s.lastFolderID++
+	folder := &feed.Folder{ID: s.lastFolderID, Name: name, CreatedAt: time.Now().UTC()}
+	s.folders[folder.ID] = folder
+
+	created := *folder
+	return &created, nil
+}
+
+// ListFolders returns every folder with its feed and unread item counts, ordered by name.
+func (s *MemoryStore) ListFolders(ctx context.Context) ([]feed.Folder, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	folders := make([]feed.Folder, 0, len(s.folders))
+	for _, folder := range s.folders {
+		folders = append(folders, s.folderWithCounts(folder))
+	}
+	sort.Slice(folders, func(i, j int) bool {
+		if a, b := strings.ToLower(folders[i].Name), strings.ToLower(folders[j].Name); a != b {
+			return a < b
+		}
+		return folders[i].ID < folders[j].ID
+	})
+	return folders, nil
+}
+
+// RenameFolder renames the folder and returns it, or nil when it does not exist.
+func (s *MemoryStore) RenameFolder(ctx context.Context, id int64, name string) (*feed.Folder, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	folder, ok := s.folders[id]
+	if !ok {
+		return nil, nil
+	}
+	if s.folderNamed(name, id) {
+		return nil, fmt.Errorf("rename folder: %w", feed.ErrConflict)
+	}
+	folder.Name = name
+
+	renamed := s.folderWithCounts(folder)
+	return &renamed, nil
+}
+
+// DeleteFolder removes the folder, leaving its feeds unfiled.
+func (s *MemoryStore) DeleteFolder(ctx context.Context, id int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.folders[id]; !ok {
+		return false, nil
+	}
+	delete(s.folders, id)
+	for _, entry := range s.feeds {
+		if entry.folderID == id {
+			entry.folderID = 0
+		}
+	}
+	return true, nil
+}
+
+// MoveFeed files the feed in the folder, or unfiles it when folderID is zero.
+func (s *MemoryStore) MoveFeed(ctx context.Context, feedID, folderID int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry := s.feedByID(feedID)
+	if entry == nil {
+		return false, nil
+	}
+	if _, ok := s.folders[folderID]; folderID != 0 && !ok {
+		return false, nil
+	}
+	entry.folderID = folderID
+	return true, nil
+}
+
+// FolderFeedURLs returns the source URLs of the feeds filed in the folder.
+func (s *MemoryStore) FolderFeedURLs(ctx context.Context, id int64) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.folders[id]; !ok {
+		return nil, nil
+	}
+	urls := []string{}
+	for url, entry := range s.feeds {
+		if entry.folderID == id {
+			urls = append(urls, url)
+		}
+	}
+	sort.Strings(urls)
+	return urls, nil
+}
+
+// SetFeedTags replaces the tags of the feed.
+func (s *MemoryStore) SetFeedTags(ctx context.Context, feedID int64, tags []string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry := s.feedByID(feedID)
+	if entry == nil {
+		return false, nil
+	}
+	entry.tags = slices.Clone(tags)
+	return true, nil
+}
+
+// ListTags returns every tag in use with the number of feeds carrying it, ordered by tag.
+func (s *MemoryStore) ListTags(ctx context.Context) ([]feed.TagCount, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	counts := make(map[string]int)
+	for _, entry := range s.feeds {
+		for _, tag := range entry.tags {
+			counts[tag]++
+		}
+	}
+
+	tags := make([]feed.TagCount, 0, len(counts))
+	for tag, count := range counts {
+		tags = append(tags, feed.TagCount{Tag: tag, FeedCount: count})
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Tag < tags[j].Tag })
+	return tags, nil
+}
+
+// TagFeedURLs returns the source URLs of the feeds carrying the tag.
+func (s *MemoryStore) TagFeedURLs(ctx context.Context, tag string) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	urls := []string{}
+	for url, entry := range s.feeds {
+		if slices.Contains(entry.tags, tag) {
+			urls = append(urls, url)
+		}
+	}
+	sort.Strings(urls)
+	return urls, nil
+}
+
+// folderNamed reports whether a folder other than except already uses the name.
+func (s *MemoryStore) folderNamed(name string, except int64) bool {
+	for id, folder := range s.folders {
+		if id != except && strings.EqualFold(folder.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *MemoryStore) folderWithCounts(folder *feed.Folder) feed.Folder {
+	result := *folder
+	for _, entry := range s.feeds {
+		if entry.folderID == folder.ID {
+			result.FeedCount++
+			result.UnreadCount += entry.unread()
+		}
+	}
+	return result
+}
+
+func (s *MemoryStore) feedByID(id int64) *memoryFeed {
+	for _, entry := range s.feeds {
+		if entry.id == id {
+			return entry
+		}
+	}
+	return nil
+}
@@ -0,0 +1,354 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+// MemoryStore keeps feed snapshots in process memory. It is safe for concurrent use
+// and suits demos and tests; everything is lost when the process exits.
+type MemoryStore struct {
+	mu         sync.RWMutex
+	feeds      map[string]*memoryFeed
+	items      map[int64]*feed.Item
+	lastItemID int64
+	lastFeedID int64
+
+	folders      map[int64]*feed.Folder
+	lastFolderID int64
+}
+
+type memoryFeed struct {
+	id       int64
+	meta     feed.Feed
+	items    map[string]*feed.Item
+	folderID int64
+	tags     []string
+}
+
+// NewMemoryStore creates an empty in-memory FeedStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		feeds:   make(map[string]*memoryFeed),
+		items:   make(map[int64]*feed.Item),
+		folders: make(map[int64]*feed.Folder),
+	}
+}
+
+// Save upserts the feed metadata for the given URL and merges its items into the
+// stored history, keyed by item identity.
+func (s *MemoryStore) Save(ctx context.Context, entry *feed.Feed) error {
+	if entry == nil {
+		return fmt.Errorf("feed entry is nil")
+	}
+
+	sourceURL := strings.TrimSpace(entry.SourceURL)
+	if sourceURL == "" {
+		return fmt.Errorf("feed source url is required")
+	}
+
+	if entry.FetchedAt.IsZero() {
+		entry.FetchedAt = time.Now().UTC()
+	}
+	if entry.CheckedAt.IsZero() {
+		entry.CheckedAt = entry.FetchedAt
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.feeds[sourceURL]
+	if !ok {
+		s.lastFeedID++
+		stored = &memoryFeed{id: s.lastFeedID, items: make(map[string]*feed.Item)}
+		s.feeds[sourceURL] = stored
+	}
+
+	stored.meta = cloneFeedMeta(entry)
+	stored.meta.SourceURL = sourceURL
+
+	for i := range entry.Items {
+		item := &entry.Items[i]
+		key := item.Key()
+
+		saved, exists := stored.items[key]
+		if !exists {
+			s.lastItemID++
+			saved = &feed.Item{ID: s.lastItemID, PublishedAt: item.PublishedAt}
+			stored.items[key] = saved
+			s.items[saved.ID] = saved
+		}
+
+		next := cloneItem(*item)
+		next.ID = saved.ID
+		next.FeedURL = sourceURL
+		next.PublishedAt = saved.PublishedAt
+		next.Read, next.Starred, next.Archived = saved.Read, saved.Starred, saved.Archived
+		*saved = next
+
+		item.ID, item.FeedURL = saved.ID, entry.SourceURL
+		item.Read, item.Starred, item.Archived = saved.Read, saved.Starred, saved.Archived
+	}
+
+	return nil
+}
+
+// ListRecent returns a page of stored feeds in the query's sort order.
+func (s *MemoryStore) ListRecent(ctx context.Context, query feed.RecentQuery) ([]feed.Summary, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	summaries := make([]feed.Summary, 0, len(s.feeds))
+	for _, entry := range s.feeds {
+		if query.FolderID != 0 && entry.folderID != query.FolderID {
+			continue
+		}
+		if query.Tag != "" && !slices.Contains(entry.tags, query.Tag) {
+			continue
+		}
+		summaries = append(summaries, feed.Summary{
+			ID:          entry.id,
+			SourceURL:   entry.meta.SourceURL,
+			Title:       entry.meta.Title,
+			Description: entry.meta.Description,
+			Link:        entry.meta.Link,
+			FetchedAt:   entry.meta.FetchedAt,
+			UnreadCount: entry.unread(),
+			FolderID:    entry.folderID,
+			Tags:        slices.Clone(entry.tags),
+		})
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaryPrecedes(feed.SummaryCursorFor(query.Sort, summaries[i]), feed.SummaryCursorFor(query.Sort, summaries[j]))
+	})
+
+	var result []feed.Summary
+	for _, summary := range summaries {
+		if !query.After.IsZero() && !summaryPrecedes(query.After, feed.SummaryCursorFor(query.Sort, summary)) {
+			continue
+		}
+		result = append(result, summary)
+		if len(result) == limit {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// summaryPrecedes reports whether a comes before b in their sort order: titles
+// ascending, fetch times and unread counts descending, ties broken by id.
+func summaryPrecedes(a, b feed.SummaryCursor) bool {
+	switch a.Sort {
+	case feed.SortTitle:
+		if ta, tb := titleSortKey(a.Title), titleSortKey(b.Title); ta != tb {
+			return ta < tb
+		}
+		return a.ID < b.ID
+	case feed.SortUnread:
+		if a.UnreadCount != b.UnreadCount {
+			return a.UnreadCount > b.UnreadCount
+		}
+	default:
+		if !a.FetchedAt.Equal(b.FetchedAt) {
+			return a.FetchedAt.After(b.FetchedAt)
+		}
+	}
+	return a.ID > b.ID
+}
+
+// FindByURL returns the latest feed snapshot for a URL along with its most recent stored items.
+func (s *MemoryStore) FindByURL(ctx context.Context, url string) (*feed.Feed, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stored, ok := s.feeds[strings.TrimSpace(url)]
+	if !ok {
+		return nil, nil
+	}
+
+	result := cloneFeedMeta(&stored.meta)
+	result.Items = stored.page(snapshotItemLimit, 0)
+	return &result, nil
+}
+
+// MarkChecked bumps the checked at timestamp of a stored feed without touching its snapshot.
+func (s *MemoryStore) MarkChecked(ctx context.Context, url string, checkedAt time.Time, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if stored, ok := s.feeds[strings.TrimSpace(url)]; ok {
+		stored.meta.CheckedAt = checkedAt
+		stored.meta.TTL = ttl
+	}
+	return nil
+}
+
+// LookupURL returns the source URL of the feed with the given id.
+func (s *MemoryStore) LookupURL(ctx context.Context, id int64) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for url, entry := range s.feeds {
+		if entry.id == id {
+			return url, nil
+		}
+	}
+	return "", nil
+}
+
+// Delete removes the feed and its items.
+func (s *MemoryStore) Delete(ctx context.Context, url string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sourceURL := strings.TrimSpace(url)
+	entry, ok := s.feeds[sourceURL]
+	if !ok {
+		return false, nil
+	}
+	for _, item := range entry.items {
+		delete(s.items, item.ID)
+	}
+	delete(s.feeds, sourceURL)
+	return true, nil
+}
+
+// Clear removes all stored feeds.
+func (s *MemoryStore) Clear(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.feeds = make(map[string]*memoryFeed)
+	s.items = make(map[int64]*feed.Item)
+	return nil
+}
+
+// ListItems returns a page of stored items for the feed, newest first.
+func (s *MemoryStore) ListItems(ctx context.Context, sourceURL string, limit, offset int) ([]feed.Item, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stored, ok := s.feeds[strings.TrimSpace(sourceURL)]
+	if !ok {
+		return nil, nil
+	}
+	if limit <= 0 {
+		limit = snapshotItemLimit
+	}
+	return stored.page(limit, max(offset, 0)), nil
+}
+
+// FindItem returns a single stored item by its identifier.
+func (s *MemoryStore) FindItem(ctx context.Context, id int64) (*feed.Item, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	item, ok := s.items[id]
+	if !ok {
+		return nil, nil
+	}
+	found := cloneItem(*item)
+	return &found, nil
+}
+
+// UpdateItemState applies a partial reading state change to a stored item.
+func (s *MemoryStore) UpdateItemState(ctx context.Context, id int64, change feed.StateChange) (*feed.Item, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.items[id]
+	if !ok {
+		return nil, nil
+	}
+	if change.Read != nil {
+		item.Read = *change.Read
+	}
+	if change.Starred != nil {
+		item.Starred = *change.Starred
+	}
+	if change.Archived != nil {
+		item.Archived = *change.Archived
+	}
+
+	updated := cloneItem(*item)
+	return &updated, nil
+}
+
+// MarkFeedRead marks the unread items of a feed as read, optionally only those published before olderThan.
+func (s *MemoryStore) MarkFeedRead(ctx context.Context, sourceURL string, olderThan time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.feeds[strings.TrimSpace(sourceURL)]
+	if !ok {
+		return 0, nil
+	}
+
+	var updated int64
+	for _, item := range stored.items {
+		if item.Read || (!olderThan.IsZero() && !item.PublishedAt.Before(olderThan)) {
+			continue
+		}
+		item.Read = true
+		updated++
+	}
+	return updated, nil
+}
+
+// unread counts the items that are neither read nor archived.
+func (f *memoryFeed) unread() int {
+	count := 0
+	for _, item := range f.items {
+		if !item.Read && !item.Archived {
+			count++
+		}
+	}
+	return count
+}
+
+// page returns copies of the feed items ordered by published at and id descending.
+func (f *memoryFeed) page(limit, offset int) []feed.Item {
+	items := make([]feed.Item, 0, len(f.items))
+	for _, item := range f.items {
+		items = append(items, cloneItem(*item))
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if !items[i].PublishedAt.Equal(items[j].PublishedAt) {
+			return items[i].PublishedAt.After(items[j].PublishedAt)
+		}
+		return items[i].ID > items[j].ID
+	})
+
+	if offset >= len(items) {
+		return nil
+	}
+	return items[offset:min(offset+limit, len(items))]
+}
+
+func cloneFeedMeta(entry *feed.Feed) feed.Feed {
+	meta := *entry
+	meta.Items = nil
+	meta.Authors = slices.Clone(entry.Authors)
+	meta.Categories = slices.Clone(entry.Categories)
+	return meta
+}
+
+func cloneItem(item feed.Item) feed.Item {
+	item.Authors = slices.Clone(item.Authors)
+	item.Categories = slices.Clone(item.Categories)
+	item.Enclosures = slices.Clone(item.Enclosures)
+	return item
+}
@@ -0,0 +1,27 @@
+package feed_test
+
+import (
+	"testing"
+
+	feedRepo "rssreader/internal/infra/feed"
+	"rssreader/internal/repository"
+	"rssreader/internal/repository/storetest"
+)
+
+func TestMemoryStoreConformance(t *testing.T) {
+	t.Run("FeedStore", func(t *testing.T) {
+		storetest.TestFeedStore(t, func(t *testing.T) repository.FeedStore { return feedRepo.NewMemoryStore() })
+	})
+	t.Run("TimelineStore", func(t *testing.T) {
+		storetest.TestTimelineStore(t, func(t *testing.T) storetest.TimelineBackend { return feedRepo.NewMemoryStore() })
+	})
+	t.Run("FolderStore", func(t *testing.T) {
+		storetest.TestFolderStore(t, func(t *testing.T) storetest.FolderBackend { return feedRepo.NewMemoryStore() })
+	})
+	t.Run("CatalogStore", func(t *testing.T) {
+		storetest.TestCatalogStore(t, func(t *testing.T) repository.CatalogStore { return feedRepo.NewMemoryCatalogStore() })
+	})
+	t.Run("FetchLogStore", func(t *testing.T) {
+		storetest.TestFetchLogStore(t, func(t *testing.T) repository.FetchLogStore { return feedRepo.NewMemoryFetchLogStore() })
+	})
+}
@@ -0,0 +1,125 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+// MemorySubscriptionStore keeps feed subscriptions in process memory and is safe for concurrent use.
+type MemorySubscriptionStore struct {
+	mu            sync.Mutex
+	subscriptions map[string]*feed.Subscription
+	lastID        int64
+}
+
+// NewMemorySubscriptionStore creates an empty in-memory SubscriptionStore.
+func NewMemorySubscriptionStore() *MemorySubscriptionStore {
+	return &MemorySubscriptionStore{subscriptions: make(map[string]*feed.Subscription)}
+}
+
+// Subscribe upserts the subscription for the given URL.
+func (s *MemorySubscriptionStore) Subscribe(ctx context.Context, entry *feed.Subscription) error {
+	if entry == nil {
+		return fmt.Errorf("subscription entry is nil")
+	}
+
+	sourceURL := strings.TrimSpace(entry.SourceURL)
+	if sourceURL == "" {
+		return fmt.Errorf("subscription source url is required")
+	}
+
+	if entry.NextPollAt.IsZero() {
+		entry.NextPollAt = time.Now().UTC()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	saved, ok := s.subscriptions[sourceURL]
+	if !ok {
+		s.lastID++
+		saved = &feed.Subscription{ID: s.lastID, SourceURL: sourceURL, NextPollAt: entry.NextPollAt, CreatedAt: time.Now().UTC()}
+		s.subscriptions[sourceURL] = saved
+	}
+
+	if title := strings.TrimSpace(entry.Title); title != "" {
+		saved.Title = title
+	}
+	if folder := strings.TrimSpace(entry.Folder); folder != "" {
+		saved.Folder = folder
+	}
+	saved.Interval = entry.Interval.Truncate(time.Second)
+	if entry.NextPollAt.Before(saved.NextPollAt) {
+		saved.NextPollAt = entry.NextPollAt
+	}
+
+	*entry = *saved
+	return nil
+}
+
+// Unsubscribe deletes the subscription for the given URL.
+func (s *MemorySubscriptionStore) Unsubscribe(ctx context.Context, url string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.subscriptions, strings.TrimSpace(url))
+	return nil
+}
+
+// List returns all subscriptions.
+func (s *MemorySubscriptionStore) List(ctx context.Context) ([]feed.Subscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result []feed.Subscription
+	for _, sub := range s.subscriptions {
+		result = append(result, *sub)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].SourceURL < result[j].SourceURL })
+	return result, nil
+}
+
+// ListDue returns the subscriptions that should be polled now.
+func (s *MemorySubscriptionStore) ListDue(ctx context.Context, now time.Time, limit int) ([]feed.Subscription, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result []feed.Subscription
+	for _, sub := range s.subscriptions {
+		if !sub.NextPollAt.After(now) {
+			result = append(result, *sub)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].NextPollAt.Before(result[j].NextPollAt) })
+	return result[:min(limit, len(result))], nil
+}
+
+// MarkPolled stores the poll outcome and schedules the next poll based on the subscription interval.
+func (s *MemorySubscriptionStore) MarkPolled(ctx context.Context, id int64, polledAt time.Time, pollErr error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sub := range s.subscriptions {
+		if sub.ID != id {
+			continue
+		}
+		sub.LastPolledAt = polledAt
+		sub.NextPollAt = polledAt.Add(sub.Interval)
+		sub.LastError = ""
+		if pollErr != nil {
+			sub.LastError = pollErr.Error()
+		}
+		return nil
+	}
+	return nil
+}
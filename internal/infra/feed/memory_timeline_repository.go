@@ -0,0 +1,79 @@
+package feed
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"rssreader/internal/domain/feed"
+)
+
+// Timeline merges the items of every stored feed, newest first. Among items sharing a
+// link, only the first stored one that matches the filters is listed.
+func (s *MemoryStore) Timeline(ctx context.Context, query feed.TimelineQuery) ([]feed.Item, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	matches := func(item *feed.Item) bool {
+		switch {
+		case query.FeedURLs != nil && !slices.Contains(query.FeedURLs, item.FeedURL):
+			return false
+		case query.Read != nil && item.Read != *query.Read:
+			return false
+		case query.Starred != nil && item.Starred != *query.Starred:
+			return false
+		case !query.From.IsZero() && item.PublishedAt.Before(query.From):
+			return false
+		case !query.To.IsZero() && !item.PublishedAt.Before(query.To):
+			return false
+		}
+		return true
+	}
+
+	// firstByLink holds the lowest matching id for every link.
+	firstByLink := make(map[string]int64)
+	var candidates []*feed.Item
+	for _, item := range s.items {
+		if !matches(item) {
+			continue
+		}
+		candidates = append(candidates, item)
+		if item.Link == "" {
+			continue
+		}
+		if first, ok := firstByLink[item.Link]; !ok || item.ID < first {
+			firstByLink[item.Link] = item.ID
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if !candidates[i].PublishedAt.Equal(candidates[j].PublishedAt) {
+			return candidates[i].PublishedAt.After(candidates[j].PublishedAt)
+		}
+		return candidates[i].ID > candidates[j].ID
+	})
+
+	var items []feed.Item
+	for _, item := range candidates {
+		if len(items) == query.Limit {
+			break
+		}
+		if item.Link != "" && firstByLink[item.Link] != item.ID {
+			continue
+		}
+		if !query.After.IsZero() && !pastCursor(item, query.After) {
+			continue
+		}
+		items = append(items, cloneItem(*item))
+	}
+
+	return items, nil
+}
+
+// pastCursor reports whether the item comes after the cursor in the descending listing.
+func pastCursor(item *feed.Item, cursor feed.Cursor) bool {
+	if !item.PublishedAt.Equal(cursor.Time) {
+		return item.PublishedAt.Before(cursor.Time)
+	}
+	return item.ID < cursor.ID
+}
@@ -0,0 +1,204 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"rssreader/internal/domain/feed"
+)
+
+const postgresCatalogSourceColumns = `id, category_id, name, url, description, disabled, checked_at, check_error, check_failures, created_at`
+
+// PostgresCatalogStore persists the curated catalog in PostgreSQL.
+type PostgresCatalogStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresCatalogStore creates a new Postgres-backed CatalogStore; the schema is managed by the migrate package.
+func NewPostgresCatalogStore(pool *pgxpool.Pool) (*PostgresCatalogStore, error) {
+	if pool == nil {
+		return nil, fmt.Errorf("pool is required")
+	}
+
+	return &PostgresCatalogStore{pool: pool}, nil
+}
+
+// SeedCatalog adds the categories and sources that are not stored yet.
+func (s *PostgresCatalogStore) SeedCatalog(ctx context.Context, categories []feed.CatalogCategory) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("seed catalog: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, category := range categories {
+		if _, err := tx.Exec(ctx, postgresInsertCatalogCategory+` ON CONFLICT (id) DO NOTHING;`,
+			category.ID, category.Name, category.Description); err != nil {
+			return fmt.Errorf("seed catalog: %w", err)
+		}
+		for _, source := range category.Sources {
+			if _, err := tx.Exec(ctx, postgresInsertCatalogSource+` ON CONFLICT (category_id, url) DO NOTHING;`,
+				category.ID, source.Name, source.URL, source.Description); err != nil {
+				return fmt.Errorf("seed catalog: %w", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("seed catalog: %w", err)
+	}
+	return nil
+}
+
+// ListCatalog returns the categories in the order they were added, each with its sources.
+func (s *PostgresCatalogStore) ListCatalog(ctx context.Context, includeDisabled bool) ([]feed.CatalogCategory, error) {
+	rows, err := s.pool.Query(ctx, `SELECT id, name, description FROM catalog_categories ORDER BY position, id;`)
+	if err != nil {
+		return nil, fmt.Errorf("list catalog: %w", err)
+	}
+	defer rows.Close()
+
+	categories := []feed.CatalogCategory{}
+	for rows.Next() {
+		category := feed.CatalogCategory{Sources: []feed.CatalogSource{}}
+		if err := rows.Scan(&category.ID, &category.Name, &category.Description); err != nil {
+			return nil, fmt.Errorf("scan catalog category: %w", err)
+		}
+		categories = append(categories, category)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	sources, err := s.querySources(ctx, `SELECT `+postgresCatalogSourceColumns+` FROM catalog_sources
+WHERE $1 OR NOT disabled ORDER BY id;`, includeDisabled)
+	if err != nil {
+		return nil, fmt.Errorf("list catalog: %w", err)
+	}
+	return groupCatalog(categories, sources), nil
+}
+
+// AddCatalogCategory stores a new category.
+func (s *PostgresCatalogStore) AddCatalogCategory(ctx context.Context, category feed.CatalogCategory) (*feed.CatalogCategory, error) {
+	_, err := s.pool.Exec(ctx, postgresInsertCatalogCategory+`;`, category.ID, category.Name, category.Description)
+	if err != nil {
+		return nil, fmt.Errorf("add catalog category: %w", postgresConflict(err))
+	}
+
+	category.Sources = nil
+	return &category, nil
+}
+
+// AddCatalogSource stores a new source, or returns nil when its category does not exist.
+func (s *PostgresCatalogStore) AddCatalogSource(ctx context.Context, source feed.CatalogSource) (*feed.CatalogSource, error) {
+	var exists bool
+	err := s.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM catalog_categories WHERE id = $1);`, source.CategoryID).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("add catalog source: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	sources, err := s.querySources(ctx, postgresInsertCatalogSource+` RETURNING `+postgresCatalogSourceColumns+`;`,
+		source.CategoryID, source.Name, source.URL, source.Description)
+	if err != nil {
+		return nil, fmt.Errorf("add catalog source: %w", postgresConflict(err))
+	}
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("add catalog source: %w", pgx.ErrNoRows)
+	}
+	return &sources[0], nil
+}
+
+// SetCatalogSourceDisabled hides or restores the source, or returns nil when it does not exist.
+func (s *PostgresCatalogStore) SetCatalogSourceDisabled(ctx context.Context, id int64, disabled bool) (*feed.CatalogSource, error) {
+	sources, err := s.querySources(ctx, `UPDATE catalog_sources SET disabled = $1 WHERE id = $2
+RETURNING `+postgresCatalogSourceColumns+`;`, disabled, id)
+	if err != nil {
+		return nil, fmt.Errorf("update catalog source: %w", err)
+	}
+	if len(sources) == 0 {
+		return nil, nil
+	}
+	return &sources[0], nil
+}
+
+// CatalogURLs returns the distinct URLs of the enabled sources.
+func (s *PostgresCatalogStore) CatalogURLs(ctx context.Context) ([]string, error) {
+	rows, err := s.pool.Query(ctx, `SELECT DISTINCT url FROM catalog_sources WHERE NOT disabled ORDER BY url;`)
+	if err != nil {
+		return nil, fmt.Errorf("list catalog urls: %w", err)
+	}
+	defer rows.Close()
+
+	urls := []string{}
+	for rows.Next() {
+		var url string
+		if err := rows.Scan(&url); err != nil {
+			return nil, fmt.Errorf("scan catalog url: %w", err)
+		}
+		urls = append(urls, url)
+	}
+	return urls, rows.Err()
+}
+
+// RecordCatalogCheck stores the outcome of a health check on every source with the URL.
+func (s *PostgresCatalogStore) RecordCatalogCheck(ctx context.Context, url string, checkedAt time.Time, checkErr string) error {
+	const query = `
+UPDATE catalog_sources
+SET checked_at = $2,
+    check_error = $3,
+    check_failures = CASE WHEN $3 = '' THEN 0 ELSE check_failures + 1 END
+WHERE url = $1;
+`
+
+	if _, err := s.pool.Exec(ctx, query, url, checkedAt, checkErr); err != nil {
+		return fmt.Errorf("record catalog check: %w", err)
+	}
+	return nil
+}
+
+const (
+	postgresInsertCatalogCategory = `
+INSERT INTO catalog_categories (id, name, description, position)
+VALUES ($1, $2, $3, (SELECT COALESCE(MAX(position), 0) + 1 FROM catalog_categories))`
+
+	postgresInsertCatalogSource = `
+INSERT INTO catalog_sources (category_id, name, url, description)
+VALUES ($1, $2, $3, $4)`
+)
+
+func (s *PostgresCatalogStore) querySources(ctx context.Context, query string, args ...any) ([]feed.CatalogSource, error) {
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sources []feed.CatalogSource
+	for rows.Next() {
+		var (
+			source    feed.CatalogSource
+			checkedAt *time.Time
+		)
+		err := rows.Scan(&source.ID, &source.CategoryID, &source.Name, &source.URL, &source.Description,
+			&source.Disabled, &checkedAt, &source.CheckError, &source.CheckFailures, &source.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("scan catalog source: %w", err)
+		}
+		if checkedAt != nil {
+			source.CheckedAt = *checkedAt
+		}
+		sources = append(sources, source)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return sources, nil
+}
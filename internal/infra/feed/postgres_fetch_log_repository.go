@@ -0,0 +1,126 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"rssreader/internal/domain/feed"
+)
+
+// PostgresFetchLogStore persists fetch attempts in PostgreSQL.
+type PostgresFetchLogStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresFetchLogStore creates a new Postgres-backed FetchLogStore; the schema is managed by the migrate package.
+func NewPostgresFetchLogStore(pool *pgxpool.Pool) (*PostgresFetchLogStore, error) {
+	if pool == nil {
+		return nil, fmt.Errorf("pool is required")
+	}
+
+	return &PostgresFetchLogStore{pool: pool}, nil
+}
+
+// RecordFetch appends the attempt, keeping at most feed.FetchLogRetention per URL.
+func (s *PostgresFetchLogStore) RecordFetch(ctx context.Context, attempt feed.FetchAttempt) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("record fetch: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	const insert = `
+INSERT INTO fetch_log (source_url, attempted_at, status_code, duration_ms, bytes, error_class, error, item_delta)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);`
+	if _, err := tx.Exec(ctx, insert,
+		attempt.SourceURL,
+		attempt.AttemptedAt.UTC(),
+		attempt.StatusCode,
+		attempt.Duration.Milliseconds(),
+		attempt.Bytes,
+		attempt.ErrorClass,
+		attempt.Error,
+		attempt.ItemDelta,
+	); err != nil {
+		return fmt.Errorf("record fetch: %w", err)
+	}
+
+	const prune = `
+DELETE FROM fetch_log WHERE source_url = $1 AND id <= (
+	SELECT id FROM fetch_log WHERE source_url = $1 ORDER BY id DESC LIMIT 1 OFFSET $2
+);`
+	if _, err := tx.Exec(ctx, prune, attempt.SourceURL, feed.FetchLogRetention); err != nil {
+		return fmt.Errorf("prune fetch log: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("record fetch: %w", err)
+	}
+	return nil
+}
+
+// FetchHistory returns up to limit attempts of each URL, newest first.
+func (s *PostgresFetchLogStore) FetchHistory(ctx context.Context, urls []string, limit int) (map[string][]feed.FetchAttempt, error) {
+	history := make(map[string][]feed.FetchAttempt)
+	if len(urls) == 0 || limit <= 0 {
+		return history, nil
+	}
+
+	const query = `
+SELECT id, source_url, attempted_at, status_code, duration_ms, bytes, error_class, error, item_delta
+FROM (
+	SELECT *, ROW_NUMBER() OVER (PARTITION BY source_url ORDER BY id DESC) AS position
+	FROM fetch_log
+	WHERE source_url = ANY($1)
+) AS recent
+WHERE position <= $2
+ORDER BY source_url, id DESC;`
+
+	rows, err := s.pool.Query(ctx, query, urls, limit)
+	if err != nil {
+		return nil, fmt.Errorf("fetch history: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			attempt    feed.FetchAttempt
+			durationMS int64
+		)
+		if err := rows.Scan(
+			&attempt.ID,
+			&attempt.SourceURL,
+			&attempt.AttemptedAt,
+			&attempt.StatusCode,
+			&durationMS,
+			&attempt.Bytes,
+			&attempt.ErrorClass,
+			&attempt.Error,
+			&attempt.ItemDelta,
+		); err != nil {
+			return nil, fmt.Errorf("scan fetch attempt: %w", err)
+		}
+		attempt.Duration = time.Duration(durationMS) * time.Millisecond
+		history[attempt.SourceURL] = append(history[attempt.SourceURL], attempt)
+	}
+	return history, rows.Err()
+}
+
+// DeleteFetchHistory removes every attempt of the URL.
+func (s *PostgresFetchLogStore) DeleteFetchHistory(ctx context.Context, url string) error {
+	if _, err := s.pool.Exec(ctx, `DELETE FROM fetch_log WHERE source_url = $1;`, url); err != nil {
+		return fmt.Errorf("delete fetch history: %w", err)
+	}
+	return nil
+}
+
+// ClearFetchHistory removes every attempt.
+func (s *PostgresFetchLogStore) ClearFetchHistory(ctx context.Context) error {
+	if _, err := s.pool.Exec(ctx, `DELETE FROM fetch_log;`); err != nil {
+		return fmt.Errorf("clear fetch history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"rssreader/internal/domain/feed"
+)
+
+// postgresFolderColumns selects a folder along with the counts of the feeds filed in it.
+const postgresFolderColumns = `
+SELECT fo.id, fo.name, fo.created_at,
+       (SELECT COUNT(*) FROM feeds f WHERE f.folder_id = fo.id),
+       (SELECT COUNT(*) FROM items i JOIN feeds f ON f.id = i.feed_id
+        WHERE f.folder_id = fo.id AND NOT i.read AND NOT i.archived)
+FROM folders fo`
+
+// uniqueViolation is the SQLSTATE Postgres reports for duplicate keys.
+const uniqueViolation = "23505"
+
+// CreateFolder stores a new empty folder.
+func (s *PostgresStore) CreateFolder(ctx context.Context, name string) (*feed.Folder, error) {
+	var folder feed.Folder
+	err := s.pool.QueryRow(ctx, `INSERT INTO folders (name) VALUES ($1) RETURNING id, name, created_at;`, name).
+		Scan(&folder.ID, &folder.Name, &folder.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("create folder: %w", postgresConflict(err))
+	}
+	return &folder, nil
+}
+
+// ListFolders returns every folder with its feed and unread item counts, ordered by name.
+func (s *PostgresStore) ListFolders(ctx context.Context) ([]feed.Folder, error) {
+	rows, err := s.pool.Query(ctx, postgresFolderColumns+` ORDER BY lower(fo.name) COLLATE "C", fo.id;`)
+	if err != nil {
+		return nil, fmt.Errorf("list folders: %w", err)
+	}
+	defer rows.Close()
+
+	var folders []feed.Folder
+	for rows.Next() {
+		var folder feed.Folder
+		if err := rows.Scan(&folder.ID, &folder.Name, &folder.CreatedAt, &folder.FeedCount, &folder.UnreadCount); err != nil {
+			return nil, fmt.Errorf("scan folder: %w", err)
+		}
+		folders = append(folders, folder)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return folders, nil
+}
+
+// RenameFolder renames the folder and returns it, or nil when it does not exist.
+func (s *PostgresStore) RenameFolder(ctx context.Context, id int64, name string) (*feed.Folder, error) {
+	tag, err := s.pool.Exec(ctx, `UPDATE folders SET name = $2 WHERE id = $1;`, id, name)
+	if err != nil {
+		return nil, fmt.Errorf("rename folder: %w", postgresConflict(err))
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, nil
+	}
+
+	var folder feed.Folder
+	err = s.pool.QueryRow(ctx, postgresFolderColumns+` WHERE fo.id = $1;`, id).
+		Scan(&folder.ID, &folder.Name, &folder.CreatedAt, &folder.FeedCount, &folder.UnreadCount)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find folder: %w", err)
+	}
+	return &folder, nil
+}
+
+// DeleteFolder removes the folder; its feeds are unfiled by the foreign key.
+func (s *PostgresStore) DeleteFolder(ctx context.Context, id int64) (bool, error) {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM folders WHERE id = $1;`, id)
+	if err != nil {
+		return false, fmt.Errorf("delete folder: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// MoveFeed files the feed in the folder, or unfiles it when folderID is zero.
+func (s *PostgresStore) MoveFeed(ctx context.Context, feedID, folderID int64) (bool, error) {
+	const query = `
+UPDATE feeds SET folder_id = NULLIF($2::bigint, 0)
+WHERE id = $1 AND ($2::bigint = 0 OR EXISTS (SELECT 1 FROM folders WHERE id = $2::bigint));
+`
+	tag, err := s.pool.Exec(ctx, query, feedID, folderID)
+	if err != nil {
+		return false, fmt.Errorf("move feed: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// FolderFeedURLs returns the source URLs of the feeds filed in the folder.
+func (s *PostgresStore) FolderFeedURLs(ctx context.Context, id int64) ([]string, error) {
+	var exists bool
+	if err := s.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM folders WHERE id = $1);`, id).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("find folder: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	return s.feedURLs(ctx, `SELECT source_url FROM feeds WHERE folder_id = $1 ORDER BY source_url;`, id)
+}
+
+// SetFeedTags replaces the tags of the feed.
+func (s *PostgresStore) SetFeedTags(ctx context.Context, feedID int64, tags []string) (bool, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("set feed tags: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Locking the feed row serializes concurrent tag updates of the same feed.
+	var id int64
+	if err := tx.QueryRow(ctx, `SELECT id FROM feeds WHERE id = $1 FOR UPDATE;`, feedID).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("set feed tags: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM feed_tags WHERE feed_id = $1;`, feedID); err != nil {
+		return false, fmt.Errorf("set feed tags: %w", err)
+	}
+	const insert = `INSERT INTO feed_tags (feed_id, tag) SELECT $1, unnest($2::text[]) ON CONFLICT DO NOTHING;`
+	if _, err := tx.Exec(ctx, insert, feedID, tags); err != nil {
+		return false, fmt.Errorf("set feed tags: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("set feed tags: %w", err)
+	}
+	return true, nil
+}
+
+// ListTags returns every tag in use with the number of feeds carrying it, ordered by tag.
+func (s *PostgresStore) ListTags(ctx context.Context) ([]feed.TagCount, error) {
+	rows, err := s.pool.Query(ctx, `SELECT tag, COUNT(*) FROM feed_tags GROUP BY tag ORDER BY tag COLLATE "C";`)
+	if err != nil {
+		return nil, fmt.Errorf("list tags: %w", err)
+	}
+	defer rows.Close()
+
+	var tags []feed.TagCount
+	for rows.Next() {
+		var tag feed.TagCount
+		if err := rows.Scan(&tag.Tag, &tag.FeedCount); err != nil {
+			return nil, fmt.Errorf("scan tag: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return tags, nil
+}
+
+// TagFeedURLs returns the source URLs of the feeds carrying the tag.
+func (s *PostgresStore) TagFeedURLs(ctx context.Context, tag string) ([]string, error) {
+	return s.feedURLs(ctx, `
+SELECT f.source_url FROM feeds f JOIN feed_tags t ON t.feed_id = f.id
+WHERE t.tag = $1
+ORDER BY f.source_url;
+`, tag)
+}
+
+func (s *PostgresStore) feedURLs(ctx context.Context, query string, args ...any) ([]string, error) {
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list feed urls: %w", err)
+	}
+	defer rows.Close()
+
+	urls := []string{}
+	for rows.Next() {
+		var url string
+		if err := rows.Scan(&url); err != nil {
+			return nil, fmt.Errorf("scan feed url: %w", err)
+		}
+		urls = append(urls, url)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return urls, nil
+}
+
+// postgresConflict reports unique constraint violations as feed.ErrConflict.
+func postgresConflict(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return feed.ErrConflict
+	}
+	return err
+}
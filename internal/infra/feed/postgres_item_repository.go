@@ -0,0 +1,285 @@
+package feed
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"rssreader/internal/domain/feed"
+)
+
+// snapshotItemLimit caps how many stored items FindByURL returns; older items
+// remain reachable through ListItems.
+const snapshotItemLimit = 200
+
+const itemColumns = `i.id, f.source_url, i.guid, i.title, i.link, i.description, i.content,
+       i.authors, i.categories, i.image_url, i.enclosures, i.published_at, i.updated_at,
+       i.read, i.starred, i.archived`
+
+// enclosureRecord is the JSON representation of an enclosure in the items table.
+type enclosureRecord struct {
+	URL    string `json:"url"`
+	Type   string `json:"type,omitempty"`
+	Length int64  `json:"length,omitempty"`
+}
+
+// ListItems returns a page of stored items for the feed, newest first.
+func (s *PostgresStore) ListItems(ctx context.Context, sourceURL string, limit, offset int) ([]feed.Item, error) {
+	lookupURL := strings.TrimSpace(sourceURL)
+	if lookupURL == "" {
+		return nil, nil
+	}
+
+	var feedID int64
+	err := s.pool.QueryRow(ctx, `SELECT id FROM feeds WHERE source_url = $1;`, lookupURL).Scan(&feedID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find feed: %w", err)
+	}
+
+	return s.listItems(ctx, feedID, limit, offset)
+}
+
+// FindItem returns a single stored item by its identifier.
+func (s *PostgresStore) FindItem(ctx context.Context, id int64) (*feed.Item, error) {
+	query := `
+SELECT ` + itemColumns + `
+FROM items i
+JOIN feeds f ON f.id = i.feed_id
+WHERE i.id = $1;
+`
+
+	item, err := scanItem(s.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find item: %w", err)
+	}
+
+	return &item, nil
+}
+
+// UpdateItemState applies a partial reading state change to a stored item.
+func (s *PostgresStore) UpdateItemState(ctx context.Context, id int64, change feed.StateChange) (*feed.Item, error) {
+	query := `
+WITH i AS (
+	UPDATE items
+	SET read = COALESCE($2, read),
+	    starred = COALESCE($3, starred),
+	    archived = COALESCE($4, archived)
+	WHERE id = $1
+	RETURNING *
+)
+SELECT ` + itemColumns + `
+FROM i
+JOIN feeds f ON f.id = i.feed_id;
+`
+
+	item, err := scanItem(s.pool.QueryRow(ctx, query, id, change.Read, change.Starred, change.Archived))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("update item state: %w", err)
+	}
+
+	return &item, nil
+}
+
+// MarkFeedRead marks the unread items of a feed as read, optionally only those published before olderThan.
+func (s *PostgresStore) MarkFeedRead(ctx context.Context, sourceURL string, olderThan time.Time) (int64, error) {
+	const query = `
+UPDATE items i
+SET read = TRUE
+FROM feeds f
+WHERE f.id = i.feed_id
+  AND f.source_url = $1
+  AND NOT i.read
+  AND ($2::timestamptz IS NULL OR i.published_at < $2);
+`
+
+	tag, err := s.pool.Exec(ctx, query, strings.TrimSpace(sourceURL), nullableTime(olderThan))
+	if err != nil {
+		return 0, fmt.Errorf("mark feed read: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (s *PostgresStore) listItems(ctx context.Context, feedID int64, limit, offset int) ([]feed.Item, error) {
+	if limit <= 0 {
+		limit = snapshotItemLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	query := `
+SELECT ` + itemColumns + `
+FROM items i
+JOIN feeds f ON f.id = i.feed_id
+WHERE i.feed_id = $1
+ORDER BY i.published_at DESC, i.id DESC
+LIMIT $2 OFFSET $3;
+`
+
+	rows, err := s.pool.Query(ctx, query, feedID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("list items: %w", err)
+	}
+	defer rows.Close()
+
+	var result []feed.Item
+	for rows.Next() {
+		item, err := scanItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan item: %w", err)
+		}
+		result = append(result, item)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return result, nil
+}
+
+// upsertItems merges the entry items into the stored history and fills in their identifiers.
+func upsertItems(ctx context.Context, tx pgx.Tx, feedID int64, entry *feed.Feed) error {
+	if len(entry.Items) == 0 {
+		return nil
+	}
+
+	const query = `
+INSERT INTO items (feed_id, item_key, guid, title, link, description, content, authors, categories,
+                   image_url, enclosures, published_at, updated_at, first_seen_at, last_seen_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $14)
+ON CONFLICT (feed_id, item_key)
+DO UPDATE SET guid = EXCLUDED.guid,
+              title = EXCLUDED.title,
+              link = EXCLUDED.link,
+              description = EXCLUDED.description,
+              content = EXCLUDED.content,
+              authors = EXCLUDED.authors,
+              categories = EXCLUDED.categories,
+              image_url = EXCLUDED.image_url,
+              enclosures = EXCLUDED.enclosures,
+              updated_at = EXCLUDED.updated_at,
+              last_seen_at = EXCLUDED.last_seen_at
+RETURNING id, read, starred, archived;
+`
+
+	batch := &pgx.Batch{}
+	for _, item := range entry.Items {
+		enclosures, err := marshalEnclosures(item.Enclosures)
+		if err != nil {
+			return err
+		}
+
+		batch.Queue(query,
+			feedID,
+			item.Key(),
+			item.GUID,
+			item.Title,
+			item.Link,
+			item.Description,
+			item.Content,
+			item.Authors,
+			item.Categories,
+			item.Image,
+			enclosures,
+			item.PublishedAt,
+			nullableTime(item.UpdatedAt),
+			entry.FetchedAt,
+		)
+	}
+
+	results := tx.SendBatch(ctx, batch)
+	for i := range entry.Items {
+		item := &entry.Items[i]
+		if err := results.QueryRow().Scan(&item.ID, &item.Read, &item.Starred, &item.Archived); err != nil {
+			results.Close()
+			return fmt.Errorf("save item: %w", err)
+		}
+		item.FeedURL = entry.SourceURL
+	}
+
+	if err := results.Close(); err != nil {
+		return fmt.Errorf("save items: %w", err)
+	}
+	return nil
+}
+
+func scanItem(row pgx.Row) (feed.Item, error) {
+	var item feed.Item
+	err := scanItemWith(row, &item)
+	return item, err
+}
+
+// scanItemWith scans the itemColumns into item, followed by any extra selected columns.
+func scanItemWith(row pgx.Row, item *feed.Item, extra ...any) error {
+	var (
+		enclosuresRaw []byte
+		updatedAt     *time.Time
+	)
+
+	dest := []any{&item.ID, &item.FeedURL, &item.GUID, &item.Title, &item.Link, &item.Description, &item.Content,
+		&item.Authors, &item.Categories, &item.Image, &enclosuresRaw, &item.PublishedAt, &updatedAt,
+		&item.Read, &item.Starred, &item.Archived}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return err
+	}
+
+	if updatedAt != nil {
+		item.UpdatedAt = *updatedAt
+	}
+
+	enclosures, err := unmarshalEnclosures(enclosuresRaw)
+	if err != nil {
+		return err
+	}
+	item.Enclosures = enclosures
+	return nil
+}
+
+func marshalEnclosures(enclosures []feed.Enclosure) ([]byte, error) {
+	if len(enclosures) == 0 {
+		return nil, nil
+	}
+
+	records := make([]enclosureRecord, 0, len(enclosures))
+	for _, enclosure := range enclosures {
+		records = append(records, enclosureRecord(enclosure))
+	}
+
+	serialized, err := json.Marshal(records)
+	if err != nil {
+		return nil, fmt.Errorf("marshal enclosures: %w", err)
+	}
+	return serialized, nil
+}
+
+func unmarshalEnclosures(raw []byte) ([]feed.Enclosure, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var records []enclosureRecord
+	if err := json.Unmarshal(raw, &records); err != nil {
+		return nil, fmt.Errorf("unmarshal enclosures: %w", err)
+	}
+
+	enclosures := make([]feed.Enclosure, 0, len(records))
+	for _, record := range records {
+		enclosures = append(enclosures, feed.Enclosure(record))
+	}
+	return enclosures, nil
+}
@@ -2,9 +2,9 @@ package feed
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,47 +19,22 @@ type PostgresStore struct {
 	pool *pgxpool.Pool
 }
 
-// NewPostgresStore creates a new Postgres-backed FeedStore and ensures schema exists.
-func NewPostgresStore(ctx context.Context, pool *pgxpool.Pool) (*PostgresStore, error) {
+// NewPostgresStore creates a new Postgres-backed FeedStore; the schema is managed by the migrate package.
+func NewPostgresStore(pool *pgxpool.Pool) (*PostgresStore, error) {
 	if pool == nil {
 		return nil, fmt.Errorf("pool is required")
 	}
 
-	store := &PostgresStore{pool: pool}
-	if err := store.ensureSchema(ctx); err != nil {
-		return nil, fmt.Errorf("ensure schema: %w", err)
-	}
-	return store, nil
+	return &PostgresStore{pool: pool}, nil
 }
 
-func (s *PostgresStore) ensureSchema(ctx context.Context) error {
-	const ddl = `
-CREATE TABLE IF NOT EXISTS feeds (
-	id SERIAL PRIMARY KEY,
-	source_url TEXT UNIQUE NOT NULL,
-	title TEXT,
-	description TEXT,
-	link TEXT,
-	items JSONB NOT NULL DEFAULT '[]'::jsonb,
-	fetched_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
-);
-`
-
-	_, err := s.pool.Exec(ctx, ddl)
-	return err
-}
-
-// Save upserts the feed snapshot for the given URL.
+// Save upserts the feed metadata for the given URL and merges its items into the
+// stored history, keyed by item identity.
 func (s *PostgresStore) Save(ctx context.Context, entry *feed.Feed) error {
 	if entry == nil {
 		return fmt.Errorf("feed entry is nil")
 	}
 
-	serialized, err := json.Marshal(entry.Items)
-	if err != nil {
-		return fmt.Errorf("marshal items: %w", err)
-	}
-
 	sourceURL := strings.TrimSpace(entry.SourceURL)
 	if sourceURL == "" {
 		return fmt.Errorf("feed source url is required")
@@ -68,47 +43,129 @@ func (s *PostgresStore) Save(ctx context.Context, entry *feed.Feed) error {
 	if entry.FetchedAt.IsZero() {
 		entry.FetchedAt = time.Now().UTC()
 	}
+	if entry.CheckedAt.IsZero() {
+		entry.CheckedAt = entry.FetchedAt
+	}
 
 	const query = `
-INSERT INTO feeds (source_url, title, description, link, items, fetched_at)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO feeds (source_url, title, description, link, fetched_at, etag, last_modified, checked_at,
+                   image_url, authors, categories, updated_at, ttl_seconds)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 ON CONFLICT (source_url)
 DO UPDATE SET title = EXCLUDED.title,
               description = EXCLUDED.description,
               link = EXCLUDED.link,
-              items = EXCLUDED.items,
-              fetched_at = EXCLUDED.fetched_at;
+              image_url = EXCLUDED.image_url,
+              authors = EXCLUDED.authors,
+              categories = EXCLUDED.categories,
+              updated_at = EXCLUDED.updated_at,
+              fetched_at = EXCLUDED.fetched_at,
+              etag = EXCLUDED.etag,
+              last_modified = EXCLUDED.last_modified,
+              checked_at = EXCLUDED.checked_at,
+              ttl_seconds = EXCLUDED.ttl_seconds
+RETURNING id;
 `
 
-	_, err = s.pool.Exec(ctx, query,
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("save feed: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var feedID int64
+	err = tx.QueryRow(ctx, query,
 		sourceURL,
 		entry.Title,
 		entry.Description,
 		entry.Link,
-		serialized,
 		entry.FetchedAt,
-	)
+		entry.ETag,
+		entry.LastModified,
+		entry.CheckedAt,
+		entry.Image,
+		entry.Authors,
+		entry.Categories,
+		nullableTime(entry.UpdatedAt),
+		int64(entry.TTL/time.Second),
+	).Scan(&feedID)
 	if err != nil {
 		return fmt.Errorf("save feed: %w", err)
 	}
 
+	if err := upsertItems(ctx, tx, feedID, entry); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("save feed: %w", err)
+	}
+
 	return nil
 }
 
-// ListRecent returns the most recent feeds.
-func (s *PostgresStore) ListRecent(ctx context.Context, limit int) ([]feed.Summary, error) {
+// postgresRecentOrders holds the ORDER BY clause and the keyset condition of each
+// feed sort; $2 is the cursor key and $3 the cursor id. Titles compare by their
+// bytewise sort key so the order does not depend on the database locale.
+var postgresRecentOrders = map[string]struct{ orderBy, after string }{
+	feed.SortFetchedAt: {
+		orderBy: "fetched_at DESC, id DESC",
+		after:   "(fetched_at, id) < ($2::timestamptz, $3::bigint)",
+	},
+	feed.SortTitle: {
+		orderBy: postgresTitleKey("title") + ` COLLATE "C", id`,
+		after:   `(` + postgresTitleKey("title") + ` COLLATE "C" > $2::text COLLATE "C" OR (` + postgresTitleKey("title") + ` = $2::text AND id > $3::bigint))`,
+	},
+	feed.SortUnread: {
+		orderBy: "unread_count DESC, id DESC",
+		after:   "(unread_count, id) < ($2::bigint, $3::bigint)",
+	},
+}
+
+// ListRecent returns a page of stored feeds in the query's sort order.
+func (s *PostgresStore) ListRecent(ctx context.Context, query feed.RecentQuery) ([]feed.Summary, error) {
+	limit := query.Limit
 	if limit <= 0 {
 		limit = 10
 	}
+	order, ok := postgresRecentOrders[query.Sort]
+	if !ok {
+		order = postgresRecentOrders[feed.SortFetchedAt]
+	}
 
-	const query = `
-SELECT source_url, title, description, link, fetched_at
-FROM feeds
-ORDER BY fetched_at DESC
+	var clauses []string
+	args := []any{limit}
+	if !query.After.IsZero() {
+		clauses = append(clauses, order.after)
+		args = append(args, postgresCursorKey(query.After), query.After.ID)
+	}
+	if query.FolderID != 0 {
+		args = append(args, query.FolderID)
+		clauses = append(clauses, "folder_id = $"+strconv.Itoa(len(args)))
+	}
+	if query.Tag != "" {
+		args = append(args, query.Tag)
+		clauses = append(clauses, "$"+strconv.Itoa(len(args))+" = ANY (tags)")
+	}
+	where := "TRUE"
+	if len(clauses) > 0 {
+		where = strings.Join(clauses, " AND ")
+	}
+
+	sql := `
+SELECT id, source_url, title, description, link, fetched_at, unread_count, folder_id, tags
+FROM (
+	SELECT f.id, f.source_url, f.title, f.description, f.link, f.fetched_at, COALESCE(f.folder_id, 0) AS folder_id,
+	       (SELECT COUNT(*) FROM items i WHERE i.feed_id = f.id AND NOT i.read AND NOT i.archived) AS unread_count,
+	       ARRAY(SELECT t.tag FROM feed_tags t WHERE t.feed_id = f.id ORDER BY t.tag) AS tags
+	FROM feeds f
+) f
+WHERE ` + where + `
+ORDER BY ` + order.orderBy + `
 LIMIT $1;
 `
 
-	rows, err := s.pool.Query(ctx, query, limit)
+	rows, err := s.pool.Query(ctx, sql, args...)
 	if err != nil {
 		return nil, fmt.Errorf("list feeds: %w", err)
 	}
@@ -117,9 +174,12 @@ LIMIT $1;
 	var result []feed.Summary
 	for rows.Next() {
 		var summary feed.Summary
-		if err := rows.Scan(&summary.SourceURL, &summary.Title, &summary.Description, &summary.Link, &summary.FetchedAt); err != nil {
+		if err := rows.Scan(&summary.ID, &summary.SourceURL, &summary.Title, &summary.Description, &summary.Link, &summary.FetchedAt, &summary.UnreadCount, &summary.FolderID, &summary.Tags); err != nil {
 			return nil, fmt.Errorf("scan feed: %w", err)
 		}
+		if len(summary.Tags) == 0 {
+			summary.Tags = nil
+		}
 		result = append(result, summary)
 	}
 
@@ -130,10 +190,22 @@ LIMIT $1;
 	return result, nil
 }
 
-// FindByURL returns the latest feed snapshot for a URL.
+func postgresCursorKey(cursor feed.SummaryCursor) any {
+	switch cursor.Sort {
+	case feed.SortTitle:
+		return titleSortKey(cursor.Title)
+	case feed.SortUnread:
+		return int64(cursor.UnreadCount)
+	default:
+		return cursor.FetchedAt
+	}
+}
+
+// FindByURL returns the latest feed snapshot for a URL along with its most recent stored items.
 func (s *PostgresStore) FindByURL(ctx context.Context, url string) (*feed.Feed, error) {
 	const query = `
-SELECT source_url, title, description, link, items, fetched_at
+SELECT id, source_url, title, description, link, fetched_at, etag, last_modified, checked_at,
+       image_url, authors, categories, updated_at, ttl_seconds
 FROM feeds
 WHERE source_url = $1;
 `
@@ -146,42 +218,84 @@ WHERE source_url = $1;
 	row := s.pool.QueryRow(ctx, query, lookupURL)
 
 	var (
-		sourceURL   string
-		title       string
-		description string
-		link        string
-		itemsRaw    []byte
-		fetchedAt   time.Time
+		feedID     int64
+		result     feed.Feed
+		checkedAt  *time.Time
+		updatedAt  *time.Time
+		ttlSeconds int64
 	)
 
-	if err := row.Scan(&sourceURL, &title, &description, &link, &itemsRaw, &fetchedAt); err != nil {
+	err := row.Scan(&feedID, &result.SourceURL, &result.Title, &result.Description, &result.Link,
+		&result.FetchedAt, &result.ETag, &result.LastModified, &checkedAt,
+		&result.Image, &result.Authors, &result.Categories, &updatedAt, &ttlSeconds)
+	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, fmt.Errorf("find feed: %w", err)
 	}
 
-	var items []feed.Item
-	if len(itemsRaw) > 0 {
-		if err := json.Unmarshal(itemsRaw, &items); err != nil {
-			return nil, fmt.Errorf("unmarshal items: %w", err)
+	result.CheckedAt = result.FetchedAt
+	if checkedAt != nil {
+		result.CheckedAt = *checkedAt
+	}
+	if updatedAt != nil {
+		result.UpdatedAt = *updatedAt
+	}
+	result.TTL = time.Duration(ttlSeconds) * time.Second
+
+	items, err := s.listItems(ctx, feedID, snapshotItemLimit, 0)
+	if err != nil {
+		return nil, err
+	}
+	result.Items = items
+
+	return &result, nil
+}
+
+// MarkChecked bumps the checked at timestamp of a stored feed without touching its snapshot.
+func (s *PostgresStore) MarkChecked(ctx context.Context, url string, checkedAt time.Time, ttl time.Duration) error {
+	const query = `UPDATE feeds SET checked_at = $2, ttl_seconds = $3 WHERE source_url = $1;`
+
+	if _, err := s.pool.Exec(ctx, query, strings.TrimSpace(url), checkedAt, int64(ttl/time.Second)); err != nil {
+		return fmt.Errorf("mark feed checked: %w", err)
+	}
+	return nil
+}
+
+// LookupURL returns the source URL of the feed with the given id.
+func (s *PostgresStore) LookupURL(ctx context.Context, id int64) (string, error) {
+	var url string
+	err := s.pool.QueryRow(ctx, `SELECT source_url FROM feeds WHERE id = $1;`, id).Scan(&url)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
 		}
+		return "", fmt.Errorf("lookup feed: %w", err)
 	}
+	return url, nil
+}
 
-	return &feed.Feed{
-		SourceURL:   sourceURL,
-		Title:       title,
-		Description: description,
-		Link:        link,
-		Items:       items,
-		FetchedAt:   fetchedAt,
-	}, nil
+// Delete removes the feed; its items follow through the foreign key cascade.
+func (s *PostgresStore) Delete(ctx context.Context, url string) (bool, error) {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM feeds WHERE source_url = $1;`, strings.TrimSpace(url))
+	if err != nil {
+		return false, fmt.Errorf("delete feed: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
 }
 
 // Clear removes all stored feeds.
 func (s *PostgresStore) Clear(ctx context.Context) error {
-	if _, err := s.pool.Exec(ctx, `TRUNCATE TABLE feeds;`); err != nil {
+	if _, err := s.pool.Exec(ctx, `TRUNCATE TABLE feeds CASCADE;`); err != nil {
 		return fmt.Errorf("clear feeds: %w", err)
 	}
 	return nil
 }
+
+func nullableTime(value time.Time) *time.Time {
+	if value.IsZero() {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,140 @@
+package feed_test
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/infra/database"
+	"rssreader/internal/infra/database/migrate"
+	feedRepo "rssreader/internal/infra/feed"
+	"rssreader/internal/repository"
+	"rssreader/internal/repository/storetest"
+)
+
+// newPostgresPool connects to the disposable database in TEST_DATABASE_URL and
+// migrates it, skipping the test when none is configured.
+func newPostgresPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+
+	pool, err := database.Connect(context.Background(), dsn, 2)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	migrator, err := migrate.New(pool)
+	if err != nil {
+		t.Fatalf("load migrations: %v", err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return pool
+}
+
+// newPostgresStore returns a store over emptied feed tables.
+func newPostgresStore(t *testing.T, pool *pgxpool.Pool) *feedRepo.PostgresStore {
+	t.Helper()
+	store, err := feedRepo.NewPostgresStore(pool)
+	if err != nil {
+		t.Fatalf("new store: %v", err)
+	}
+	if err := store.Clear(context.Background()); err != nil {
+		t.Fatalf("clear: %v", err)
+	}
+	return store
+}
+
+// truncate empties tables the feed store's Clear keeps.
+func truncate(t *testing.T, pool *pgxpool.Pool, tables string) {
+	t.Helper()
+	if _, err := pool.Exec(context.Background(), `TRUNCATE TABLE `+tables+` CASCADE;`); err != nil {
+		t.Fatalf("truncate %s: %v", tables, err)
+	}
+}
+
+// TestPostgresStoreConformance needs a disposable database in TEST_DATABASE_URL; its tables are truncated.
+func TestPostgresStoreConformance(t *testing.T) {
+	pool := newPostgresPool(t)
+
+	t.Run("FeedStore", func(t *testing.T) {
+		storetest.TestFeedStore(t, func(t *testing.T) repository.FeedStore { return newPostgresStore(t, pool) })
+	})
+	t.Run("TimelineStore", func(t *testing.T) {
+		storetest.TestTimelineStore(t, func(t *testing.T) storetest.TimelineBackend { return newPostgresStore(t, pool) })
+	})
+	t.Run("FolderStore", func(t *testing.T) {
+		storetest.TestFolderStore(t, func(t *testing.T) storetest.FolderBackend {
+			// Truncating folders also empties the feeds filed in them.
+			truncate(t, pool, "folders")
+			return newPostgresStore(t, pool)
+		})
+	})
+	t.Run("CatalogStore", func(t *testing.T) {
+		storetest.TestCatalogStore(t, func(t *testing.T) repository.CatalogStore {
+			truncate(t, pool, "catalog_categories")
+			store, err := feedRepo.NewPostgresCatalogStore(pool)
+			if err != nil {
+				t.Fatalf("new catalog store: %v", err)
+			}
+			return store
+		})
+	})
+	t.Run("FetchLogStore", func(t *testing.T) {
+		storetest.TestFetchLogStore(t, func(t *testing.T) repository.FetchLogStore {
+			truncate(t, pool, "fetch_log")
+			store, err := feedRepo.NewPostgresFetchLogStore(pool)
+			if err != nil {
+				t.Fatalf("new fetch log store: %v", err)
+			}
+			return store
+		})
+	})
+}
+
+// TestPostgresSearchEscapesSnippets needs a disposable database in TEST_DATABASE_URL; its tables are truncated.
+func TestPostgresSearchEscapesSnippets(t *testing.T) {
+	ctx := context.Background()
+	store := newPostgresStore(t, newPostgresPool(t))
+
+	err := store.Save(ctx, &feed.Feed{
+		SourceURL: "https://example.com/rss",
+		Title:     "Example",
+		FetchedAt: time.Now(),
+		Items: []feed.Item{{
+			GUID:        "1",
+			Title:       `<img src=x onerror=alert(1)> Eleições`,
+			Link:        "https://example.com/1",
+			Description: `<p>Resultado das eleições <img src=x onerror=alert(1) `,
+			PublishedAt: time.Now(),
+		}},
+	})
+	if err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	hits, err := store.Search(ctx, feed.SearchQuery{Text: "eleições", Limit: 10})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if len(hits) != 1 {
+		t.Fatalf("expected one hit, got %d", len(hits))
+	}
+	if want := `&lt;img src=x onerror=alert(1)&gt; <mark>Eleições</mark>`; hits[0].TitleSnippet != want {
+		t.Errorf("expected title snippet %q, got %q", want, hits[0].TitleSnippet)
+	}
+	if snippet := hits[0].Snippet; strings.Contains(snippet, "<img") || strings.Contains(snippet, "onerror") ||
+		!strings.Contains(snippet, "<mark>eleições</mark>") {
+		t.Errorf("unexpected content snippet %q", snippet)
+	}
+}
@@ -0,0 +1,101 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+
+	"rssreader/internal/domain/feed"
+)
+
+// ts_headline delimits matches with private-use characters rather than markup, so the
+// publisher's text can be escaped before the <mark> tags are added. The characters are
+// removed from the searched text first.
+const (
+	matchStart = "\uE000"
+	matchStop  = "\uE001"
+)
+
+const (
+	// titleHeadlineOptions highlights every match in the whole title.
+	titleHeadlineOptions = `StartSel=` + matchStart + `, StopSel=` + matchStop + `, HighlightAll=true`
+	// headlineOptions returns short excerpts of the item body.
+	headlineOptions = `StartSel=` + matchStart + `, StopSel=` + matchStop + `, MaxWords=35, MinWords=15, MaxFragments=2, FragmentDelimiter=" … "`
+)
+
+var matchMarker = strings.NewReplacer(matchStart, "<mark>", matchStop, "</mark>")
+
+// Search runs a Portuguese full-text search over item titles, descriptions and content.
+func (s *PostgresStore) Search(ctx context.Context, query feed.SearchQuery) ([]feed.SearchHit, error) {
+	sql := `
+WITH q AS (SELECT websearch_to_tsquery('portuguese', $1) AS query)
+SELECT ` + itemColumns + `,
+       ts_rank_cd(i.search_vector, q.query) AS rank,
+       ts_headline('portuguese', translate(i.title, '` + matchStart + matchStop + `', ''),
+                   q.query, '` + titleHeadlineOptions + `') AS title_snippet,
+       ts_headline('portuguese',
+                   translate(regexp_replace(COALESCE(NULLIF(i.content, ''), i.description), '<[^>]*(>|$)', ' ', 'g'),
+                             '` + matchStart + matchStop + `', ''),
+                   q.query, '` + headlineOptions + `') AS snippet
+FROM items i
+JOIN feeds f ON f.id = i.feed_id
+CROSS JOIN q
+WHERE i.search_vector @@ q.query
+  AND ($2::text IS NULL OR f.source_url = $2)
+  AND ($3::timestamptz IS NULL OR i.published_at >= $3)
+  AND ($4::timestamptz IS NULL OR i.published_at < $4)
+ORDER BY rank DESC, i.published_at DESC, i.id DESC
+LIMIT $5 OFFSET $6;
+`
+
+	var feedURL *string
+	if trimmed := strings.TrimSpace(query.FeedURL); trimmed != "" {
+		feedURL = &trimmed
+	}
+
+	rows, err := s.pool.Query(ctx, sql,
+		query.Text,
+		feedURL,
+		nullableTime(query.From),
+		nullableTime(query.To),
+		query.Limit,
+		query.Offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("search items: %w", err)
+	}
+	defer rows.Close()
+
+	var hits []feed.SearchHit
+	for rows.Next() {
+		var (
+			hit  feed.SearchHit
+			rank float32
+		)
+		if err := scanItemWith(rows, &hit.Item, &rank, &hit.TitleSnippet, &hit.Snippet); err != nil {
+			return nil, fmt.Errorf("scan search hit: %w", err)
+		}
+		hit.Rank = float64(rank)
+		hit.TitleSnippet = titleSnippet(hit.TitleSnippet)
+		hit.Snippet = contentSnippet(hit.Snippet)
+		hits = append(hits, hit)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return hits, nil
+}
+
+// titleSnippet renders a headline of the title, which is plain text, as HTML.
+func titleSnippet(headline string) string {
+	return matchMarker.Replace(html.EscapeString(headline))
+}
+
+// contentSnippet renders a headline of the item body as HTML. The body was stripped of
+// its tags but its entities are still encoded, so they are decoded before escaping.
+func contentSnippet(headline string) string {
+	return matchMarker.Replace(html.EscapeString(html.UnescapeString(headline)))
+}
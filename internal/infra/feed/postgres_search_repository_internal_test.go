@@ -0,0 +1,33 @@
+package feed
+
+import "testing"
+
+func TestSnippetsEscapePublisherMarkup(t *testing.T) {
+	cases := []struct {
+		name     string
+		render   func(string) string
+		headline string
+		want     string
+	}{
+		{
+			name:     "hostile title",
+			render:   titleSnippet,
+			headline: `<img src=x onerror=alert(1)> ` + matchStart + `Rust` + matchStop + ` & "Go"`,
+			want:     `&lt;img src=x onerror=alert(1)&gt; <mark>Rust</mark> &amp; &#34;Go&#34;`,
+		},
+		{
+			name:     "encoded markup in content",
+			render:   contentSnippet,
+			headline: `Tom &amp; ` + matchStart + `Jerry` + matchStop + ` &lt;script&gt;alert(1)&lt;/script&gt;`,
+			want:     `Tom &amp; <mark>Jerry</mark> &lt;script&gt;alert(1)&lt;/script&gt;`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.render(tc.headline); got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
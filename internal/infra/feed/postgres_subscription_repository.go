@@ -0,0 +1,168 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"rssreader/internal/domain/feed"
+)
+
+// PostgresSubscriptionStore persists feed subscriptions in PostgreSQL.
+type PostgresSubscriptionStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresSubscriptionStore creates a new Postgres-backed SubscriptionStore; the schema is managed by the migrate package.
+func NewPostgresSubscriptionStore(pool *pgxpool.Pool) (*PostgresSubscriptionStore, error) {
+	if pool == nil {
+		return nil, fmt.Errorf("pool is required")
+	}
+
+	return &PostgresSubscriptionStore{pool: pool}, nil
+}
+
+// Subscribe upserts the subscription for the given URL.
+func (s *PostgresSubscriptionStore) Subscribe(ctx context.Context, entry *feed.Subscription) error {
+	if entry == nil {
+		return fmt.Errorf("subscription entry is nil")
+	}
+
+	sourceURL := strings.TrimSpace(entry.SourceURL)
+	if sourceURL == "" {
+		return fmt.Errorf("subscription source url is required")
+	}
+
+	if entry.NextPollAt.IsZero() {
+		entry.NextPollAt = time.Now().UTC()
+	}
+
+	const query = `
+INSERT INTO subscriptions (source_url, title, folder, interval_seconds, next_poll_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (source_url)
+DO UPDATE SET title = COALESCE(NULLIF(EXCLUDED.title, ''), subscriptions.title),
+              folder = COALESCE(NULLIF(EXCLUDED.folder, ''), subscriptions.folder),
+              interval_seconds = EXCLUDED.interval_seconds,
+              next_poll_at = LEAST(subscriptions.next_poll_at, EXCLUDED.next_poll_at)
+RETURNING id, source_url, title, folder, interval_seconds, last_polled_at, next_poll_at, last_error, created_at;
+`
+
+	row := s.pool.QueryRow(ctx, query,
+		sourceURL,
+		strings.TrimSpace(entry.Title),
+		strings.TrimSpace(entry.Folder),
+		int64(entry.Interval/time.Second),
+		entry.NextPollAt,
+	)
+	saved, err := scanSubscription(row)
+	if err != nil {
+		return fmt.Errorf("save subscription: %w", err)
+	}
+
+	*entry = saved
+	return nil
+}
+
+// Unsubscribe deletes the subscription for the given URL.
+func (s *PostgresSubscriptionStore) Unsubscribe(ctx context.Context, url string) error {
+	if _, err := s.pool.Exec(ctx, `DELETE FROM subscriptions WHERE source_url = $1;`, strings.TrimSpace(url)); err != nil {
+		return fmt.Errorf("delete subscription: %w", err)
+	}
+	return nil
+}
+
+// List returns all subscriptions.
+func (s *PostgresSubscriptionStore) List(ctx context.Context) ([]feed.Subscription, error) {
+	const query = `
+SELECT id, source_url, title, folder, interval_seconds, last_polled_at, next_poll_at, last_error, created_at
+FROM subscriptions
+ORDER BY source_url;
+`
+
+	return s.query(ctx, query)
+}
+
+// ListDue returns the subscriptions that should be polled now.
+func (s *PostgresSubscriptionStore) ListDue(ctx context.Context, now time.Time, limit int) ([]feed.Subscription, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	const query = `
+SELECT id, source_url, title, folder, interval_seconds, last_polled_at, next_poll_at, last_error, created_at
+FROM subscriptions
+WHERE next_poll_at <= $1
+ORDER BY next_poll_at
+LIMIT $2;
+`
+
+	return s.query(ctx, query, now, limit)
+}
+
+// MarkPolled stores the poll outcome and schedules the next poll based on the subscription interval.
+func (s *PostgresSubscriptionStore) MarkPolled(ctx context.Context, id int64, polledAt time.Time, pollErr error) error {
+	var lastError string
+	if pollErr != nil {
+		lastError = pollErr.Error()
+	}
+
+	const query = `
+UPDATE subscriptions
+SET last_polled_at = $2,
+    next_poll_at = $2 + interval_seconds * INTERVAL '1 second',
+    last_error = $3
+WHERE id = $1;
+`
+
+	if _, err := s.pool.Exec(ctx, query, id, polledAt, lastError); err != nil {
+		return fmt.Errorf("mark subscription polled: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresSubscriptionStore) query(ctx context.Context, query string, args ...any) ([]feed.Subscription, error) {
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	var result []feed.Subscription
+	for rows.Next() {
+		sub, err := scanSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan subscription: %w", err)
+		}
+		result = append(result, sub)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return result, nil
+}
+
+func scanSubscription(row pgx.Row) (feed.Subscription, error) {
+	var (
+		sub          feed.Subscription
+		seconds      int64
+		lastPolledAt *time.Time
+	)
+	err := row.Scan(&sub.ID, &sub.SourceURL, &sub.Title, &sub.Folder, &seconds, &lastPolledAt,
+		&sub.NextPollAt, &sub.LastError, &sub.CreatedAt)
+	if err != nil {
+		return feed.Subscription{}, err
+	}
+
+	sub.Interval = time.Duration(seconds) * time.Second
+	if lastPolledAt != nil {
+		sub.LastPolledAt = *lastPolledAt
+	}
+	return sub, nil
+}
@@ -0,0 +1,68 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+
+	"rssreader/internal/domain/feed"
+)
+
+// Timeline merges the items of every stored feed, newest first. Among items sharing a
+// link, only the first stored one that matches the filters is listed, so the choice
+// does not depend on the page being read.
+func (s *PostgresStore) Timeline(ctx context.Context, query feed.TimelineQuery) ([]feed.Item, error) {
+	const filters = `
+	  ($1::text[] IS NULL OR %[1]s.source_url = ANY($1))
+	  AND ($2::boolean IS NULL OR %[2]s.read = $2)
+	  AND ($3::boolean IS NULL OR %[2]s.starred = $3)
+	  AND ($4::timestamptz IS NULL OR %[2]s.published_at >= $4)
+	  AND ($5::timestamptz IS NULL OR %[2]s.published_at < $5)`
+
+	sql := `
+SELECT ` + itemColumns + `
+FROM items i
+JOIN feeds f ON f.id = i.feed_id
+WHERE ` + fmt.Sprintf(filters, "f", "i") + `
+  AND ($6::timestamptz IS NULL OR (i.published_at, i.id) < ($6, $7))
+  AND (i.link = '' OR NOT EXISTS (
+	SELECT 1
+	FROM items d
+	JOIN feeds df ON df.id = d.feed_id
+	WHERE d.link = i.link
+	  AND d.id < i.id
+	  AND ` + fmt.Sprintf(filters, "df", "d") + `
+  ))
+ORDER BY i.published_at DESC, i.id DESC
+LIMIT $8;
+`
+
+	rows, err := s.pool.Query(ctx, sql,
+		query.FeedURLs,
+		query.Read,
+		query.Starred,
+		nullableTime(query.From),
+		nullableTime(query.To),
+		nullableTime(query.After.Time),
+		query.After.ID,
+		query.Limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list timeline: %w", err)
+	}
+	defer rows.Close()
+
+	var items []feed.Item
+	for rows.Next() {
+		item, err := scanItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan item: %w", err)
+		}
+		items = append(items, item)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return items, nil
+}
@@ -0,0 +1,225 @@
+package feed
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+const sqliteCatalogSourceColumns = `id, category_id, name, url, description, disabled, checked_at, check_error, check_failures, created_at`
+
+// SQLiteCatalogStore persists the curated catalog in a SQLite database.
+type SQLiteCatalogStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteCatalogStore creates a new SQLite-backed CatalogStore; the schema is managed by the migrate package.
+func NewSQLiteCatalogStore(db *sql.DB) (*SQLiteCatalogStore, error) {
+	if db == nil {
+		return nil, fmt.Errorf("db is required")
+	}
+
+	return &SQLiteCatalogStore{db: db}, nil
+}
+
+// SeedCatalog adds the categories and sources that are not stored yet.
+func (s *SQLiteCatalogStore) SeedCatalog(ctx context.Context, categories []feed.CatalogCategory) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("seed catalog: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := sqliteTime(time.Now())
+	for _, category := range categories {
+		if _, err := tx.ExecContext(ctx, sqliteInsertCatalogCategory+` ON CONFLICT (id) DO NOTHING;`,
+			category.ID, category.Name, category.Description, now); err != nil {
+			return fmt.Errorf("seed catalog: %w", err)
+		}
+		for _, source := range category.Sources {
+			if _, err := tx.ExecContext(ctx, sqliteInsertCatalogSource+` ON CONFLICT (category_id, url) DO NOTHING;`,
+				category.ID, source.Name, source.URL, source.Description, now); err != nil {
+				return fmt.Errorf("seed catalog: %w", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("seed catalog: %w", err)
+	}
+	return nil
+}
+
+// ListCatalog returns the categories in the order they were added, each with its sources.
+func (s *SQLiteCatalogStore) ListCatalog(ctx context.Context, includeDisabled bool) ([]feed.CatalogCategory, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT id, name, description FROM catalog_categories ORDER BY position, id;`)
+	if err != nil {
+		return nil, fmt.Errorf("list catalog: %w", err)
+	}
+	defer rows.Close()
+
+	categories := []feed.CatalogCategory{}
+	for rows.Next() {
+		category := feed.CatalogCategory{Sources: []feed.CatalogSource{}}
+		if err := rows.Scan(&category.ID, &category.Name, &category.Description); err != nil {
+			return nil, fmt.Errorf("scan catalog category: %w", err)
+		}
+		categories = append(categories, category)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	sources, err := s.querySources(ctx, `SELECT `+sqliteCatalogSourceColumns+` FROM catalog_sources
+WHERE ? OR NOT disabled ORDER BY id;`, includeDisabled)
+	if err != nil {
+		return nil, fmt.Errorf("list catalog: %w", err)
+	}
+	return groupCatalog(categories, sources), nil
+}
+
+// AddCatalogCategory stores a new category.
+func (s *SQLiteCatalogStore) AddCatalogCategory(ctx context.Context, category feed.CatalogCategory) (*feed.CatalogCategory, error) {
+	_, err := s.db.ExecContext(ctx, sqliteInsertCatalogCategory+`;`,
+		category.ID, category.Name, category.Description, sqliteTime(time.Now()))
+	if err != nil {
+		return nil, fmt.Errorf("add catalog category: %w", sqliteConflict(err))
+	}
+
+	category.Sources = nil
+	return &category, nil
+}
+
+// AddCatalogSource stores a new source, or returns nil when its category does not exist.
+func (s *SQLiteCatalogStore) AddCatalogSource(ctx context.Context, source feed.CatalogSource) (*feed.CatalogSource, error) {
+	var exists bool
+	err := s.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM catalog_categories WHERE id = ?);`, source.CategoryID).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("add catalog source: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	sources, err := s.querySources(ctx, sqliteInsertCatalogSource+` RETURNING `+sqliteCatalogSourceColumns+`;`,
+		source.CategoryID, source.Name, source.URL, source.Description, sqliteTime(time.Now()))
+	if err != nil {
+		return nil, fmt.Errorf("add catalog source: %w", sqliteConflict(err))
+	}
+	if len(sources) == 0 {
+		return nil, errors.New("add catalog source: no row returned")
+	}
+	return &sources[0], nil
+}
+
+// SetCatalogSourceDisabled hides or restores the source, or returns nil when it does not exist.
+func (s *SQLiteCatalogStore) SetCatalogSourceDisabled(ctx context.Context, id int64, disabled bool) (*feed.CatalogSource, error) {
+	sources, err := s.querySources(ctx, `UPDATE catalog_sources SET disabled = ? WHERE id = ?
+RETURNING `+sqliteCatalogSourceColumns+`;`, disabled, id)
+	if err != nil {
+		return nil, fmt.Errorf("update catalog source: %w", err)
+	}
+	if len(sources) == 0 {
+		return nil, nil
+	}
+	return &sources[0], nil
+}
+
+// CatalogURLs returns the distinct URLs of the enabled sources.
+func (s *SQLiteCatalogStore) CatalogURLs(ctx context.Context) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT DISTINCT url FROM catalog_sources WHERE NOT disabled ORDER BY url;`)
+	if err != nil {
+		return nil, fmt.Errorf("list catalog urls: %w", err)
+	}
+	defer rows.Close()
+
+	urls := []string{}
+	for rows.Next() {
+		var url string
+		if err := rows.Scan(&url); err != nil {
+			return nil, fmt.Errorf("scan catalog url: %w", err)
+		}
+		urls = append(urls, url)
+	}
+	return urls, rows.Err()
+}
+
+// RecordCatalogCheck stores the outcome of a health check on every source with the URL.
+func (s *SQLiteCatalogStore) RecordCatalogCheck(ctx context.Context, url string, checkedAt time.Time, checkErr string) error {
+	const query = `
+UPDATE catalog_sources
+SET checked_at = ?,
+    check_error = ?,
+    check_failures = CASE WHEN ? = '' THEN 0 ELSE check_failures + 1 END
+WHERE url = ?;
+`
+
+	if _, err := s.db.ExecContext(ctx, query, sqliteTime(checkedAt), checkErr, checkErr, url); err != nil {
+		return fmt.Errorf("record catalog check: %w", err)
+	}
+	return nil
+}
+
+const (
+	sqliteInsertCatalogCategory = `
+INSERT INTO catalog_categories (id, name, description, position, created_at)
+VALUES (?, ?, ?, (SELECT COALESCE(MAX(position), 0) + 1 FROM catalog_categories), ?)`
+
+	sqliteInsertCatalogSource = `
+INSERT INTO catalog_sources (category_id, name, url, description, created_at)
+VALUES (?, ?, ?, ?, ?)`
+)
+
+func (s *SQLiteCatalogStore) querySources(ctx context.Context, query string, args ...any) ([]feed.CatalogSource, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sources []feed.CatalogSource
+	for rows.Next() {
+		var (
+			source    feed.CatalogSource
+			checkedAt sql.NullString
+			createdAt string
+		)
+		err := rows.Scan(&source.ID, &source.CategoryID, &source.Name, &source.URL, &source.Description,
+			&source.Disabled, &checkedAt, &source.CheckError, &source.CheckFailures, &createdAt)
+		if err != nil {
+			return nil, fmt.Errorf("scan catalog source: %w", err)
+		}
+		if source.CreatedAt, err = parseSQLiteTime(createdAt); err != nil {
+			return nil, err
+		}
+		if checkedAt.Valid {
+			if source.CheckedAt, err = parseSQLiteTime(checkedAt.String); err != nil {
+				return nil, err
+			}
+		}
+		sources = append(sources, source)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return sources, nil
+}
+
+// groupCatalog files the sources, ordered by id, under their categories.
+func groupCatalog(categories []feed.CatalogCategory, sources []feed.CatalogSource) []feed.CatalogCategory {
+	index := make(map[string]int, len(categories))
+	for i, category := range categories {
+		index[category.ID] = i
+	}
+	for _, source := range sources {
+		if i, ok := index[source.CategoryID]; ok {
+			categories[i].Sources = append(categories[i].Sources, source)
+		}
+	}
+	return categories
+}
@@ -0,0 +1,135 @@
+package feed
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+// SQLiteFetchLogStore persists fetch attempts in a SQLite database.
+type SQLiteFetchLogStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteFetchLogStore creates a new SQLite-backed FetchLogStore; the schema is managed by the migrate package.
+func NewSQLiteFetchLogStore(db *sql.DB) (*SQLiteFetchLogStore, error) {
+	if db == nil {
+		return nil, fmt.Errorf("db is required")
+	}
+
+	return &SQLiteFetchLogStore{db: db}, nil
+}
+
+// RecordFetch appends the attempt, keeping at most feed.FetchLogRetention per URL.
+func (s *SQLiteFetchLogStore) RecordFetch(ctx context.Context, attempt feed.FetchAttempt) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("record fetch: %w", err)
+	}
+	defer tx.Rollback()
+
+	const insert = `
+INSERT INTO fetch_log (source_url, attempted_at, status_code, duration_ms, bytes, error_class, error, item_delta)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);`
+	if _, err := tx.ExecContext(ctx, insert,
+		attempt.SourceURL,
+		sqliteTime(attempt.AttemptedAt),
+		attempt.StatusCode,
+		attempt.Duration.Milliseconds(),
+		attempt.Bytes,
+		attempt.ErrorClass,
+		attempt.Error,
+		attempt.ItemDelta,
+	); err != nil {
+		return fmt.Errorf("record fetch: %w", err)
+	}
+
+	const prune = `
+DELETE FROM fetch_log WHERE source_url = ? AND id <= (
+	SELECT id FROM fetch_log WHERE source_url = ? ORDER BY id DESC LIMIT 1 OFFSET ?
+);`
+	if _, err := tx.ExecContext(ctx, prune, attempt.SourceURL, attempt.SourceURL, feed.FetchLogRetention); err != nil {
+		return fmt.Errorf("prune fetch log: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("record fetch: %w", err)
+	}
+	return nil
+}
+
+// FetchHistory returns up to limit attempts of each URL, newest first.
+func (s *SQLiteFetchLogStore) FetchHistory(ctx context.Context, urls []string, limit int) (map[string][]feed.FetchAttempt, error) {
+	history := make(map[string][]feed.FetchAttempt)
+	if len(urls) == 0 || limit <= 0 {
+		return history, nil
+	}
+
+	query := `
+SELECT id, source_url, attempted_at, status_code, duration_ms, bytes, error_class, error, item_delta
+FROM (
+	SELECT *, ROW_NUMBER() OVER (PARTITION BY source_url ORDER BY id DESC) AS position
+	FROM fetch_log
+	WHERE source_url IN (?` + strings.Repeat(", ?", len(urls)-1) + `)
+)
+WHERE position <= ?
+ORDER BY source_url, id DESC;`
+	args := make([]any, 0, len(urls)+1)
+	for _, url := range urls {
+		args = append(args, url)
+	}
+	args = append(args, limit)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("fetch history: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			attempt     feed.FetchAttempt
+			attemptedAt string
+			durationMS  int64
+		)
+		if err := rows.Scan(
+			&attempt.ID,
+			&attempt.SourceURL,
+			&attemptedAt,
+			&attempt.StatusCode,
+			&durationMS,
+			&attempt.Bytes,
+			&attempt.ErrorClass,
+			&attempt.Error,
+			&attempt.ItemDelta,
+		); err != nil {
+			return nil, fmt.Errorf("scan fetch attempt: %w", err)
+		}
+		if attempt.AttemptedAt, err = parseSQLiteTime(attemptedAt); err != nil {
+			return nil, err
+		}
+		attempt.Duration = time.Duration(durationMS) * time.Millisecond
+		history[attempt.SourceURL] = append(history[attempt.SourceURL], attempt)
+	}
+	return history, rows.Err()
+}
+
+// DeleteFetchHistory removes every attempt of the URL.
+func (s *SQLiteFetchLogStore) DeleteFetchHistory(ctx context.Context, url string) error {
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM fetch_log WHERE source_url = ?;`, url); err != nil {
+		return fmt.Errorf("delete fetch history: %w", err)
+	}
+	return nil
+}
+
+// ClearFetchHistory removes every attempt.
+func (s *SQLiteFetchLogStore) ClearFetchHistory(ctx context.Context) error {
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM fetch_log;`); err != nil {
+		return fmt.Errorf("clear fetch history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"rssreader/internal/domain/feed"
+)
+
+// sqliteFolderColumns selects a folder along with the counts of the feeds filed in it.
+const sqliteFolderColumns = `
+SELECT fo.id, fo.name, fo.created_at,
+       (SELECT COUNT(*) FROM feeds f WHERE f.folder_id = fo.id),
+       (SELECT COUNT(*) FROM items i JOIN feeds f ON f.id = i.feed_id
+        WHERE f.folder_id = fo.id AND NOT i.read AND NOT i.archived)
+FROM folders fo`
+
+// CreateFolder stores a new empty folder.
+func (s *SQLiteStore) CreateFolder(ctx context.Context, name string) (*feed.Folder, error) {
+	folder := feed.Folder{Name: name, CreatedAt: time.Now().UTC()}
+	err := s.db.QueryRowContext(ctx, `INSERT INTO folders (name, created_at) VALUES (?, ?) RETURNING id;`,
+		name, sqliteTime(folder.CreatedAt)).Scan(&folder.ID)
+	if err != nil {
+		return nil, fmt.Errorf("create folder: %w", sqliteConflict(err))
+	}
+	return &folder, nil
+}
+
+// ListFolders returns every folder with its feed and unread item counts, ordered by name.
+func (s *SQLiteStore) ListFolders(ctx context.Context) ([]feed.Folder, error) {
+	rows, err := s.db.QueryContext(ctx, sqliteFolderColumns+` ORDER BY lower(fo.name), fo.id;`)
+	if err != nil {
+		return nil, fmt.Errorf("list folders: %w", err)
+	}
+	defer rows.Close()
+
+	var folders []feed.Folder
+	for rows.Next() {
+		folder, err := scanSQLiteFolder(rows)
+		if err != nil {
+			return nil, err
+		}
+		folders = append(folders, folder)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return folders, nil
+}
+
+// RenameFolder renames the folder and returns it, or nil when it does not exist.
+func (s *SQLiteStore) RenameFolder(ctx context.Context, id int64, name string) (*feed.Folder, error) {
+	result, err := s.db.ExecContext(ctx, `UPDATE folders SET name = ? WHERE id = ?;`, name, id)
+	if err != nil {
+		return nil, fmt.Errorf("rename folder: %w", sqliteConflict(err))
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("rename folder: %w", err)
+	}
+	if affected == 0 {
+		return nil, nil
+	}
+
+	folder, err := scanSQLiteFolder(s.db.QueryRowContext(ctx, sqliteFolderColumns+` WHERE fo.id = ?;`, id))
+	if err != nil {
+		return nil, err
+	}
+	return &folder, nil
+}
+
+// DeleteFolder removes the folder; its feeds are unfiled by the foreign key.
+func (s *SQLiteStore) DeleteFolder(ctx context.Context, id int64) (bool, error) {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM folders WHERE id = ?;`, id)
+	if err != nil {
+		return false, fmt.Errorf("delete folder: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("delete folder: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// MoveFeed files the feed in the folder, or unfiles it when folderID is zero.
+func (s *SQLiteStore) MoveFeed(ctx context.Context, feedID, folderID int64) (bool, error) {
+	const query = `
+UPDATE feeds SET folder_id = NULLIF(?1, 0)
+WHERE id = ?2 AND (?1 = 0 OR EXISTS (SELECT 1 FROM folders WHERE id = ?1));
+`
+	result, err := s.db.ExecContext(ctx, query, folderID, feedID)
+	if err != nil {
+		return false, fmt.Errorf("move feed: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("move feed: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// FolderFeedURLs returns the source URLs of the feeds filed in the folder.
+func (s *SQLiteStore) FolderFeedURLs(ctx context.Context, id int64) ([]string, error) {
+	var exists bool
+	if err := s.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM folders WHERE id = ?);`, id).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("find folder: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	return s.feedURLs(ctx, `SELECT source_url FROM feeds WHERE folder_id = ? ORDER BY source_url;`, id)
+}
+
+// SetFeedTags replaces the tags of the feed.
+func (s *SQLiteStore) SetFeedTags(ctx context.Context, feedID int64, tags []string) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("set feed tags: %w", err)
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM feeds WHERE id = ?);`, feedID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("set feed tags: %w", err)
+	}
+	if !exists {
+		return false, nil
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM feed_tags WHERE feed_id = ?;`, feedID); err != nil {
+		return false, fmt.Errorf("set feed tags: %w", err)
+	}
+	for _, tag := range tags {
+		if _, err := tx.ExecContext(ctx, `INSERT OR IGNORE INTO feed_tags (feed_id, tag) VALUES (?, ?);`, feedID, tag); err != nil {
+			return false, fmt.Errorf("set feed tags: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("set feed tags: %w", err)
+	}
+	return true, nil
+}
+
+// ListTags returns every tag in use with the number of feeds carrying it, ordered by tag.
+func (s *SQLiteStore) ListTags(ctx context.Context) ([]feed.TagCount, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT tag, COUNT(*) FROM feed_tags GROUP BY tag ORDER BY tag;`)
+	if err != nil {
+		return nil, fmt.Errorf("list tags: %w", err)
+	}
+	defer rows.Close()
+
+	var tags []feed.TagCount
+	for rows.Next() {
+		var tag feed.TagCount
+		if err := rows.Scan(&tag.Tag, &tag.FeedCount); err != nil {
+			return nil, fmt.Errorf("scan tag: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return tags, nil
+}
+
+// TagFeedURLs returns the source URLs of the feeds carrying the tag.
+func (s *SQLiteStore) TagFeedURLs(ctx context.Context, tag string) ([]string, error) {
+	return s.feedURLs(ctx, `
+SELECT f.source_url FROM feeds f JOIN feed_tags t ON t.feed_id = f.id
+WHERE t.tag = ?
+ORDER BY f.source_url;
+`, tag)
+}
+
+func (s *SQLiteStore) feedURLs(ctx context.Context, query string, args ...any) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list feed urls: %w", err)
+	}
+	defer rows.Close()
+
+	urls := []string{}
+	for rows.Next() {
+		var url string
+		if err := rows.Scan(&url); err != nil {
+			return nil, fmt.Errorf("scan feed url: %w", err)
+		}
+		urls = append(urls, url)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return urls, nil
+}
+
+func scanSQLiteFolder(row interface{ Scan(...any) error }) (feed.Folder, error) {
+	var (
+		folder    feed.Folder
+		createdAt string
+	)
+	if err := row.Scan(&folder.ID, &folder.Name, &createdAt, &folder.FeedCount, &folder.UnreadCount); err != nil {
+		return feed.Folder{}, fmt.Errorf("scan folder: %w", err)
+	}
+	var err error
+	if folder.CreatedAt, err = parseSQLiteTime(createdAt); err != nil {
+		return feed.Folder{}, fmt.Errorf("scan folder: %w", err)
+	}
+	return folder, nil
+}
+
+// sqliteConflict reports unique and primary key violations as feed.ErrConflict.
+func sqliteConflict(err error) error {
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		switch sqliteErr.Code() {
+		case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+			return feed.ErrConflict
+		}
+	}
+	return err
+}
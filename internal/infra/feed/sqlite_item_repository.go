@@ -0,0 +1,251 @@
+package feed
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+const sqliteItemColumns = `i.id, f.source_url, i.guid, i.title, i.link, i.description, i.content,
+       i.authors, i.categories, i.image_url, i.enclosures, i.published_at, i.updated_at,
+       i.read, i.starred, i.archived`
+
+// ListItems returns a page of stored items for the feed, newest first.
+func (s *SQLiteStore) ListItems(ctx context.Context, sourceURL string, limit, offset int) ([]feed.Item, error) {
+	lookupURL := strings.TrimSpace(sourceURL)
+	if lookupURL == "" {
+		return nil, nil
+	}
+
+	var feedID int64
+	err := s.db.QueryRowContext(ctx, `SELECT id FROM feeds WHERE source_url = ?;`, lookupURL).Scan(&feedID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find feed: %w", err)
+	}
+
+	return s.listItems(ctx, feedID, limit, offset)
+}
+
+// FindItem returns a single stored item by its identifier.
+func (s *SQLiteStore) FindItem(ctx context.Context, id int64) (*feed.Item, error) {
+	query := `
+SELECT ` + sqliteItemColumns + `
+FROM items i
+JOIN feeds f ON f.id = i.feed_id
+WHERE i.id = ?;
+`
+
+	item, err := scanSQLiteItem(s.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find item: %w", err)
+	}
+
+	return &item, nil
+}
+
+// UpdateItemState applies a partial reading state change to a stored item.
+func (s *SQLiteStore) UpdateItemState(ctx context.Context, id int64, change feed.StateChange) (*feed.Item, error) {
+	const query = `
+UPDATE items
+SET read = COALESCE(?, read),
+    starred = COALESCE(?, starred),
+    archived = COALESCE(?, archived)
+WHERE id = ?;
+`
+
+	result, err := s.db.ExecContext(ctx, query, change.Read, change.Starred, change.Archived, id)
+	if err != nil {
+		return nil, fmt.Errorf("update item state: %w", err)
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return nil, nil
+	}
+
+	return s.FindItem(ctx, id)
+}
+
+// MarkFeedRead marks the unread items of a feed as read, optionally only those published before olderThan.
+func (s *SQLiteStore) MarkFeedRead(ctx context.Context, sourceURL string, olderThan time.Time) (int64, error) {
+	const query = `
+UPDATE items
+SET read = 1
+WHERE feed_id = (SELECT id FROM feeds WHERE source_url = ?)
+  AND NOT read
+  AND (? IS NULL OR published_at < ?);
+`
+
+	cutoff := sqliteNullableTime(olderThan)
+	result, err := s.db.ExecContext(ctx, query, strings.TrimSpace(sourceURL), cutoff, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("mark feed read: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+func (s *SQLiteStore) listItems(ctx context.Context, feedID int64, limit, offset int) ([]feed.Item, error) {
+	if limit <= 0 {
+		limit = snapshotItemLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	query := `
+SELECT ` + sqliteItemColumns + `
+FROM items i
+JOIN feeds f ON f.id = i.feed_id
+WHERE i.feed_id = ?
+ORDER BY i.published_at DESC, i.id DESC
+LIMIT ? OFFSET ?;
+`
+
+	rows, err := s.db.QueryContext(ctx, query, feedID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("list items: %w", err)
+	}
+	defer rows.Close()
+
+	var result []feed.Item
+	for rows.Next() {
+		item, err := scanSQLiteItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan item: %w", err)
+		}
+		result = append(result, item)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return result, nil
+}
+
+// sqliteUpsertItems merges the entry items into the stored history and fills in their identifiers.
+func sqliteUpsertItems(ctx context.Context, tx *sql.Tx, feedID int64, entry *feed.Feed) error {
+	if len(entry.Items) == 0 {
+		return nil
+	}
+
+	const query = `
+INSERT INTO items (feed_id, item_key, guid, title, link, description, content, authors, categories,
+                   image_url, enclosures, published_at, updated_at, first_seen_at, last_seen_at)
+VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11, ?12, ?13, ?14, ?14)
+ON CONFLICT (feed_id, item_key)
+DO UPDATE SET guid = excluded.guid,
+              title = excluded.title,
+              link = excluded.link,
+              description = excluded.description,
+              content = excluded.content,
+              authors = excluded.authors,
+              categories = excluded.categories,
+              image_url = excluded.image_url,
+              enclosures = excluded.enclosures,
+              updated_at = excluded.updated_at,
+              last_seen_at = excluded.last_seen_at
+RETURNING id, read, starred, archived;
+`
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("save items: %w", err)
+	}
+	defer stmt.Close()
+
+	for i := range entry.Items {
+		item := &entry.Items[i]
+
+		enclosures, err := marshalEnclosures(item.Enclosures)
+		if err != nil {
+			return err
+		}
+		authors, err := marshalStrings(item.Authors)
+		if err != nil {
+			return err
+		}
+		categories, err := marshalStrings(item.Categories)
+		if err != nil {
+			return err
+		}
+
+		var enclosuresText *string
+		if enclosures != nil {
+			text := string(enclosures)
+			enclosuresText = &text
+		}
+
+		err = stmt.QueryRowContext(ctx,
+			feedID,
+			item.Key(),
+			item.GUID,
+			item.Title,
+			item.Link,
+			item.Description,
+			item.Content,
+			authors,
+			categories,
+			item.Image,
+			enclosuresText,
+			sqliteTime(item.PublishedAt),
+			sqliteNullableTime(item.UpdatedAt),
+			sqliteTime(entry.FetchedAt),
+		).Scan(&item.ID, &item.Read, &item.Starred, &item.Archived)
+		if err != nil {
+			return fmt.Errorf("save item: %w", err)
+		}
+		item.FeedURL = entry.SourceURL
+	}
+
+	return nil
+}
+
+func scanSQLiteItem(row interface{ Scan(...any) error }) (feed.Item, error) {
+	var (
+		item        feed.Item
+		authors     sql.NullString
+		categories  sql.NullString
+		enclosures  sql.NullString
+		publishedAt string
+		updatedAt   sql.NullString
+	)
+
+	err := row.Scan(&item.ID, &item.FeedURL, &item.GUID, &item.Title, &item.Link, &item.Description, &item.Content,
+		&authors, &categories, &item.Image, &enclosures, &publishedAt, &updatedAt,
+		&item.Read, &item.Starred, &item.Archived)
+	if err != nil {
+		return feed.Item{}, err
+	}
+
+	if item.PublishedAt, err = parseSQLiteTime(publishedAt); err != nil {
+		return feed.Item{}, err
+	}
+	if updatedAt.Valid {
+		if item.UpdatedAt, err = parseSQLiteTime(updatedAt.String); err != nil {
+			return feed.Item{}, err
+		}
+	}
+	if item.Authors, err = unmarshalStrings(authors); err != nil {
+		return feed.Item{}, err
+	}
+	if item.Categories, err = unmarshalStrings(categories); err != nil {
+		return feed.Item{}, err
+	}
+	if enclosures.Valid {
+		if item.Enclosures, err = unmarshalEnclosures([]byte(enclosures.String)); err != nil {
+			return feed.Item{}, err
+		}
+	}
+
+	return item, nil
+}
@@ -0,0 +1,381 @@
+package feed
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+// sqliteTimeLayout stores timestamps as fixed-width UTC text so they sort chronologically.
+const sqliteTimeLayout = "2006-01-02T15:04:05.000000000Z"
+
+// SQLiteStore persists feed snapshots in a SQLite database.
+type SQLiteStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteStore creates a new SQLite-backed FeedStore; the schema is managed by the migrate package.
+func NewSQLiteStore(db *sql.DB) (*SQLiteStore, error) {
+	if db == nil {
+		return nil, fmt.Errorf("db is required")
+	}
+
+	return &SQLiteStore{db: db}, nil
+}
+
+// Save upserts the feed metadata for the given URL and merges its items into the
+// stored history, keyed by item identity.
+func (s *SQLiteStore) Save(ctx context.Context, entry *feed.Feed) error {
+	if entry == nil {
+		return fmt.Errorf("feed entry is nil")
+	}
+
+	sourceURL := strings.TrimSpace(entry.SourceURL)
+	if sourceURL == "" {
+		return fmt.Errorf("feed source url is required")
+	}
+
+	if entry.FetchedAt.IsZero() {
+		entry.FetchedAt = time.Now().UTC()
+	}
+	if entry.CheckedAt.IsZero() {
+		entry.CheckedAt = entry.FetchedAt
+	}
+
+	authors, err := marshalStrings(entry.Authors)
+	if err != nil {
+		return err
+	}
+	categories, err := marshalStrings(entry.Categories)
+	if err != nil {
+		return err
+	}
+
+	const query = `
+INSERT INTO feeds (source_url, title, description, link, fetched_at, etag, last_modified, checked_at,
+                   image_url, authors, categories, updated_at, ttl_seconds)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (source_url)
+DO UPDATE SET title = excluded.title,
+              description = excluded.description,
+              link = excluded.link,
+              image_url = excluded.image_url,
+              authors = excluded.authors,
+              categories = excluded.categories,
+              updated_at = excluded.updated_at,
+              fetched_at = excluded.fetched_at,
+              etag = excluded.etag,
+              last_modified = excluded.last_modified,
+              checked_at = excluded.checked_at,
+              ttl_seconds = excluded.ttl_seconds
+RETURNING id;
+`
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("save feed: %w", err)
+	}
+	defer tx.Rollback()
+
+	entry.SourceURL = sourceURL
+
+	var feedID int64
+	err = tx.QueryRowContext(ctx, query,
+		sourceURL,
+		entry.Title,
+		entry.Description,
+		entry.Link,
+		sqliteTime(entry.FetchedAt),
+		entry.ETag,
+		entry.LastModified,
+		sqliteTime(entry.CheckedAt),
+		entry.Image,
+		authors,
+		categories,
+		sqliteNullableTime(entry.UpdatedAt),
+		int64(entry.TTL/time.Second),
+	).Scan(&feedID)
+	if err != nil {
+		return fmt.Errorf("save feed: %w", err)
+	}
+
+	if err := sqliteUpsertItems(ctx, tx, feedID, entry); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("save feed: %w", err)
+	}
+
+	return nil
+}
+
+// sqliteRecentOrders holds the ORDER BY clause and the keyset condition of each
+// feed sort; the condition takes the cursor key and id as parameters.
+var sqliteRecentOrders = map[string]struct{ orderBy, after string }{
+	feed.SortFetchedAt: {
+		orderBy: "fetched_at DESC, id DESC",
+		after:   "(fetched_at, id) < (?, ?)",
+	},
+	feed.SortTitle: {
+		orderBy: sqliteTitleKey("title") + ", id",
+		after:   "(" + sqliteTitleKey("title") + ", id) > (?, ?)",
+	},
+	feed.SortUnread: {
+		orderBy: "unread_count DESC, id DESC",
+		after:   "(unread_count, id) < (?, ?)",
+	},
+}
+
+// ListRecent returns a page of stored feeds in the query's sort order.
+func (s *SQLiteStore) ListRecent(ctx context.Context, query feed.RecentQuery) ([]feed.Summary, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	order, ok := sqliteRecentOrders[query.Sort]
+	if !ok {
+		order = sqliteRecentOrders[feed.SortFetchedAt]
+	}
+
+	var (
+		clauses []string
+		args    []any
+	)
+	if query.FolderID != 0 {
+		clauses = append(clauses, "folder_id = ?")
+		args = append(args, query.FolderID)
+	}
+	if query.Tag != "" {
+		clauses = append(clauses, "EXISTS (SELECT 1 FROM feed_tags t WHERE t.feed_id = id AND t.tag = ?)")
+		args = append(args, query.Tag)
+	}
+	if !query.After.IsZero() {
+		clauses = append(clauses, order.after)
+		args = append(args, sqliteCursorKey(query.After), query.After.ID)
+	}
+	where := "1 = 1"
+	if len(clauses) > 0 {
+		where = strings.Join(clauses, " AND ")
+	}
+	args = append(args, limit)
+
+	sql := `
+SELECT id, source_url, title, description, link, fetched_at, unread_count, folder_id, tags
+FROM (
+	SELECT f.id, f.source_url, f.title, f.description, f.link, f.fetched_at, COALESCE(f.folder_id, 0) AS folder_id,
+	       (SELECT COUNT(*) FROM items i WHERE i.feed_id = f.id AND NOT i.read AND NOT i.archived) AS unread_count,
+	       (SELECT json_group_array(t.tag) FROM feed_tags t WHERE t.feed_id = f.id) AS tags
+	FROM feeds f
+)
+WHERE ` + where + `
+ORDER BY ` + order.orderBy + `
+LIMIT ?;
+`
+
+	rows, err := s.db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list feeds: %w", err)
+	}
+	defer rows.Close()
+
+	var result []feed.Summary
+	for rows.Next() {
+		var (
+			summary   feed.Summary
+			fetchedAt string
+			tags      string
+		)
+		if err := rows.Scan(&summary.ID, &summary.SourceURL, &summary.Title, &summary.Description, &summary.Link, &fetchedAt, &summary.UnreadCount, &summary.FolderID, &tags); err != nil {
+			return nil, fmt.Errorf("scan feed: %w", err)
+		}
+		if summary.FetchedAt, err = parseSQLiteTime(fetchedAt); err != nil {
+			return nil, fmt.Errorf("scan feed: %w", err)
+		}
+		if err := json.Unmarshal([]byte(tags), &summary.Tags); err != nil {
+			return nil, fmt.Errorf("scan feed: %w", err)
+		}
+		if len(summary.Tags) == 0 {
+			summary.Tags = nil
+		}
+		slices.Sort(summary.Tags)
+		result = append(result, summary)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return result, nil
+}
+
+func sqliteCursorKey(cursor feed.SummaryCursor) any {
+	switch cursor.Sort {
+	case feed.SortTitle:
+		return titleSortKey(cursor.Title)
+	case feed.SortUnread:
+		return cursor.UnreadCount
+	default:
+		return sqliteTime(cursor.FetchedAt)
+	}
+}
+
+// FindByURL returns the latest feed snapshot for a URL along with its most recent stored items.
+func (s *SQLiteStore) FindByURL(ctx context.Context, url string) (*feed.Feed, error) {
+	const query = `
+SELECT id, source_url, title, description, link, fetched_at, etag, last_modified, checked_at,
+       image_url, authors, categories, updated_at, ttl_seconds
+FROM feeds
+WHERE source_url = ?;
+`
+
+	lookupURL := strings.TrimSpace(url)
+	if lookupURL == "" {
+		return nil, nil
+	}
+
+	var (
+		feedID     int64
+		result     feed.Feed
+		fetchedAt  string
+		checkedAt  sql.NullString
+		updatedAt  sql.NullString
+		authors    sql.NullString
+		categories sql.NullString
+		ttlSeconds int64
+	)
+
+	err := s.db.QueryRowContext(ctx, query, lookupURL).Scan(&feedID, &result.SourceURL, &result.Title,
+		&result.Description, &result.Link, &fetchedAt, &result.ETag, &result.LastModified, &checkedAt,
+		&result.Image, &authors, &categories, &updatedAt, &ttlSeconds)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find feed: %w", err)
+	}
+
+	if result.FetchedAt, err = parseSQLiteTime(fetchedAt); err != nil {
+		return nil, fmt.Errorf("find feed: %w", err)
+	}
+	result.CheckedAt = result.FetchedAt
+	if checkedAt.Valid {
+		if result.CheckedAt, err = parseSQLiteTime(checkedAt.String); err != nil {
+			return nil, fmt.Errorf("find feed: %w", err)
+		}
+	}
+	if updatedAt.Valid {
+		if result.UpdatedAt, err = parseSQLiteTime(updatedAt.String); err != nil {
+			return nil, fmt.Errorf("find feed: %w", err)
+		}
+	}
+	if result.Authors, err = unmarshalStrings(authors); err != nil {
+		return nil, err
+	}
+	if result.Categories, err = unmarshalStrings(categories); err != nil {
+		return nil, err
+	}
+	result.TTL = time.Duration(ttlSeconds) * time.Second
+
+	items, err := s.listItems(ctx, feedID, snapshotItemLimit, 0)
+	if err != nil {
+		return nil, err
+	}
+	result.Items = items
+
+	return &result, nil
+}
+
+// MarkChecked bumps the checked at timestamp of a stored feed without touching its snapshot.
+func (s *SQLiteStore) MarkChecked(ctx context.Context, url string, checkedAt time.Time, ttl time.Duration) error {
+	const query = `UPDATE feeds SET checked_at = ?, ttl_seconds = ? WHERE source_url = ?;`
+
+	if _, err := s.db.ExecContext(ctx, query, sqliteTime(checkedAt), int64(ttl/time.Second), strings.TrimSpace(url)); err != nil {
+		return fmt.Errorf("mark feed checked: %w", err)
+	}
+	return nil
+}
+
+// LookupURL returns the source URL of the feed with the given id.
+func (s *SQLiteStore) LookupURL(ctx context.Context, id int64) (string, error) {
+	var url string
+	err := s.db.QueryRowContext(ctx, `SELECT source_url FROM feeds WHERE id = ?;`, id).Scan(&url)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("lookup feed: %w", err)
+	}
+	return url, nil
+}
+
+// Delete removes the feed; its items follow through the foreign key cascade.
+func (s *SQLiteStore) Delete(ctx context.Context, url string) (bool, error) {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM feeds WHERE source_url = ?;`, strings.TrimSpace(url))
+	if err != nil {
+		return false, fmt.Errorf("delete feed: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("delete feed: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// Clear removes all stored feeds.
+func (s *SQLiteStore) Clear(ctx context.Context) error {
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM feeds;`); err != nil {
+		return fmt.Errorf("clear feeds: %w", err)
+	}
+	return nil
+}
+
+func sqliteTime(value time.Time) string {
+	return value.UTC().Format(sqliteTimeLayout)
+}
+
+func sqliteNullableTime(value time.Time) *string {
+	if value.IsZero() {
+		return nil
+	}
+	formatted := sqliteTime(value)
+	return &formatted
+}
+
+func parseSQLiteTime(value string) (time.Time, error) {
+	parsed, err := time.Parse(sqliteTimeLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse timestamp %q: %w", value, err)
+	}
+	return parsed, nil
+}
+
+func marshalStrings(values []string) (*string, error) {
+	if values == nil {
+		return nil, nil
+	}
+	serialized, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("marshal list: %w", err)
+	}
+	text := string(serialized)
+	return &text, nil
+}
+
+func unmarshalStrings(raw sql.NullString) ([]string, error) {
+	if !raw.Valid {
+		return nil, nil
+	}
+	var values []string
+	if err := json.Unmarshal([]byte(raw.String), &values); err != nil {
+		return nil, fmt.Errorf("unmarshal list: %w", err)
+	}
+	return values, nil
+}
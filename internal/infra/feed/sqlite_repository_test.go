@@ -0,0 +1,192 @@
+package feed_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/infra/database"
+	"rssreader/internal/infra/database/migrate"
+	feedRepo "rssreader/internal/infra/feed"
+	"rssreader/internal/repository"
+	"rssreader/internal/repository/storetest"
+)
+
+// newSQLiteDB opens a migrated database file that is closed when the test ends.
+func newSQLiteDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db := openSQLiteDB(t)
+	migrateSQLite(t, db)
+	return db
+}
+
+func openSQLiteDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := database.OpenSQLite(context.Background(), "sqlite://"+filepath.Join(t.TempDir(), "feeds.db"))
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func migrateSQLite(t *testing.T, db *sql.DB) []migrate.Migration {
+	t.Helper()
+	migrator, err := migrate.NewSQLite(db)
+	if err != nil {
+		t.Fatalf("new migrator: %v", err)
+	}
+	applied, err := migrator.Up(context.Background())
+	if err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return applied
+}
+
+func newSQLiteStore(t *testing.T) *feedRepo.SQLiteStore {
+	t.Helper()
+	store, err := feedRepo.NewSQLiteStore(newSQLiteDB(t))
+	if err != nil {
+		t.Fatalf("new store: %v", err)
+	}
+	return store
+}
+
+func TestSQLiteStoreConformance(t *testing.T) {
+	t.Run("FeedStore", func(t *testing.T) {
+		storetest.TestFeedStore(t, func(t *testing.T) repository.FeedStore { return newSQLiteStore(t) })
+	})
+	t.Run("TimelineStore", func(t *testing.T) {
+		storetest.TestTimelineStore(t, func(t *testing.T) storetest.TimelineBackend { return newSQLiteStore(t) })
+	})
+	t.Run("FolderStore", func(t *testing.T) {
+		storetest.TestFolderStore(t, func(t *testing.T) storetest.FolderBackend { return newSQLiteStore(t) })
+	})
+	t.Run("CatalogStore", func(t *testing.T) {
+		storetest.TestCatalogStore(t, func(t *testing.T) repository.CatalogStore {
+			store, err := feedRepo.NewSQLiteCatalogStore(newSQLiteDB(t))
+			if err != nil {
+				t.Fatalf("new catalog store: %v", err)
+			}
+			return store
+		})
+	})
+	t.Run("FetchLogStore", func(t *testing.T) {
+		storetest.TestFetchLogStore(t, func(t *testing.T) repository.FetchLogStore {
+			store, err := feedRepo.NewSQLiteFetchLogStore(newSQLiteDB(t))
+			if err != nil {
+				t.Fatalf("new fetch log store: %v", err)
+			}
+			return store
+		})
+	})
+}
+
+func TestSQLiteStoreItemState(t *testing.T) {
+	ctx := context.Background()
+	store := newSQLiteStore(t)
+
+	published := time.Date(2024, time.May, 10, 12, 0, 0, 0, time.UTC)
+	entry := &feed.Feed{
+		SourceURL: "https://example.com/rss.xml",
+		Items: []feed.Item{
+			{GUID: "old", Title: "Old", PublishedAt: published.Add(-time.Hour)},
+			{GUID: "new", Title: "New", PublishedAt: published},
+		},
+	}
+	if err := store.Save(ctx, entry); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	starred := true
+	item, err := store.UpdateItemState(ctx, entry.Items[1].ID, feed.StateChange{Starred: &starred})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if item == nil || !item.Starred || item.Read {
+		t.Fatalf("expected only starred to change, got %+v", item)
+	}
+
+	missing, err := store.UpdateItemState(ctx, 9999, feed.StateChange{Starred: &starred})
+	if err != nil || missing != nil {
+		t.Fatalf("expected nil for unknown item, got %+v, %v", missing, err)
+	}
+
+	updated, err := store.MarkFeedRead(ctx, entry.SourceURL, published)
+	if err != nil {
+		t.Fatalf("mark read: %v", err)
+	}
+	if updated != 1 {
+		t.Fatalf("expected only the older item to be marked read, got %d", updated)
+	}
+
+	items, err := store.ListItems(ctx, entry.SourceURL, 10, 0)
+	if err != nil {
+		t.Fatalf("list items: %v", err)
+	}
+	if len(items) != 2 || items[0].Read || !items[1].Read {
+		t.Fatalf("unexpected read state: %+v", items)
+	}
+}
+
+func TestSQLiteMigrationsAdoptUnversionedSchema(t *testing.T) {
+	// The feeds table as created before the SQLite schema was versioned: once before
+	// freshness lifetimes were stored, once with every column the stores added since.
+	const columns = `
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	source_url TEXT UNIQUE NOT NULL,
+	title TEXT NOT NULL DEFAULT '',
+	description TEXT NOT NULL DEFAULT '',
+	link TEXT NOT NULL DEFAULT '',
+	image_url TEXT NOT NULL DEFAULT '',
+	authors TEXT,
+	categories TEXT,
+	updated_at TEXT,
+	fetched_at TEXT NOT NULL,
+	etag TEXT NOT NULL DEFAULT '',
+	last_modified TEXT NOT NULL DEFAULT '',
+	checked_at TEXT`
+	cases := map[string]string{
+		"before ttl": `CREATE TABLE feeds (` + columns + `);`,
+		"latest": `
+CREATE TABLE folders (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT NOT NULL, created_at TEXT NOT NULL);
+CREATE TABLE feeds (` + columns + `,
+	ttl_seconds INTEGER NOT NULL DEFAULT 0,
+	folder_id INTEGER REFERENCES folders (id) ON DELETE SET NULL
+);`,
+	}
+
+	for name, legacy := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			db := openSQLiteDB(t)
+			if _, err := db.ExecContext(ctx, legacy); err != nil {
+				t.Fatalf("create legacy schema: %v", err)
+			}
+
+			migrateSQLite(t, db)
+			store, err := feedRepo.NewSQLiteStore(db)
+			if err != nil {
+				t.Fatalf("new store: %v", err)
+			}
+			entry := &feed.Feed{SourceURL: "https://example.com/rss.xml", TTL: time.Hour}
+			if err := store.Save(ctx, entry); err != nil {
+				t.Fatalf("save: %v", err)
+			}
+			found, err := store.FindByURL(ctx, entry.SourceURL)
+			if err != nil {
+				t.Fatalf("find: %v", err)
+			}
+			if found.TTL != time.Hour {
+				t.Errorf("expected ttl to be stored, got %v", found.TTL)
+			}
+
+			if applied := migrateSQLite(t, db); len(applied) != 0 {
+				t.Fatalf("expected an adopted database to be up to date, applied %+v", applied)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package feed
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+const sqliteSubscriptionColumns = `id, source_url, title, folder, interval_seconds, last_polled_at, next_poll_at, last_error, created_at`
+
+// SQLiteSubscriptionStore persists feed subscriptions in a SQLite database.
+type SQLiteSubscriptionStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteSubscriptionStore creates a new SQLite-backed SubscriptionStore; the schema is managed by the migrate package.
+func NewSQLiteSubscriptionStore(db *sql.DB) (*SQLiteSubscriptionStore, error) {
+	if db == nil {
+		return nil, fmt.Errorf("db is required")
+	}
+
+	return &SQLiteSubscriptionStore{db: db}, nil
+}
+
+// Subscribe upserts the subscription for the given URL.
+func (s *SQLiteSubscriptionStore) Subscribe(ctx context.Context, entry *feed.Subscription) error {
+	if entry == nil {
+		return fmt.Errorf("subscription entry is nil")
+	}
+
+	sourceURL := strings.TrimSpace(entry.SourceURL)
+	if sourceURL == "" {
+		return fmt.Errorf("subscription source url is required")
+	}
+
+	if entry.NextPollAt.IsZero() {
+		entry.NextPollAt = time.Now().UTC()
+	}
+
+	const query = `
+INSERT INTO subscriptions (source_url, title, folder, interval_seconds, next_poll_at, created_at)
+VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT (source_url)
+DO UPDATE SET title = COALESCE(NULLIF(excluded.title, ''), subscriptions.title),
+              folder = COALESCE(NULLIF(excluded.folder, ''), subscriptions.folder),
+              interval_seconds = excluded.interval_seconds,
+              next_poll_at = MIN(subscriptions.next_poll_at, excluded.next_poll_at)
+RETURNING ` + sqliteSubscriptionColumns + `;
+`
+
+	row := s.db.QueryRowContext(ctx, query,
+		sourceURL,
+		strings.TrimSpace(entry.Title),
+		strings.TrimSpace(entry.Folder),
+		int64(entry.Interval/time.Second),
+		sqliteTime(entry.NextPollAt),
+		sqliteTime(time.Now()),
+	)
+	saved, err := scanSQLiteSubscription(row)
+	if err != nil {
+		return fmt.Errorf("save subscription: %w", err)
+	}
+
+	*entry = saved
+	return nil
+}
+
+// Unsubscribe deletes the subscription for the given URL.
+func (s *SQLiteSubscriptionStore) Unsubscribe(ctx context.Context, url string) error {
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM subscriptions WHERE source_url = ?;`, strings.TrimSpace(url)); err != nil {
+		return fmt.Errorf("delete subscription: %w", err)
+	}
+	return nil
+}
+
+// List returns all subscriptions.
+func (s *SQLiteSubscriptionStore) List(ctx context.Context) ([]feed.Subscription, error) {
+	return s.query(ctx, `SELECT `+sqliteSubscriptionColumns+` FROM subscriptions ORDER BY source_url;`)
+}
+
+// ListDue returns the subscriptions that should be polled now.
+func (s *SQLiteSubscriptionStore) ListDue(ctx context.Context, now time.Time, limit int) ([]feed.Subscription, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	query := `
+SELECT ` + sqliteSubscriptionColumns + `
+FROM subscriptions
+WHERE next_poll_at <= ?
+ORDER BY next_poll_at
+LIMIT ?;
+`
+
+	return s.query(ctx, query, sqliteTime(now), limit)
+}
+
+// MarkPolled stores the poll outcome and schedules the next poll based on the subscription interval.
+func (s *SQLiteSubscriptionStore) MarkPolled(ctx context.Context, id int64, polledAt time.Time, pollErr error) error {
+	var lastError string
+	if pollErr != nil {
+		lastError = pollErr.Error()
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("mark subscription polled: %w", err)
+	}
+	defer tx.Rollback()
+
+	var seconds int64
+	err = tx.QueryRowContext(ctx, `SELECT interval_seconds FROM subscriptions WHERE id = ?;`, id).Scan(&seconds)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("mark subscription polled: %w", err)
+	}
+
+	const query = `
+UPDATE subscriptions
+SET last_polled_at = ?,
+    next_poll_at = ?,
+    last_error = ?
+WHERE id = ?;
+`
+
+	next := polledAt.Add(time.Duration(seconds) * time.Second)
+	if _, err := tx.ExecContext(ctx, query, sqliteTime(polledAt), sqliteTime(next), lastError, id); err != nil {
+		return fmt.Errorf("mark subscription polled: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("mark subscription polled: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLiteSubscriptionStore) query(ctx context.Context, query string, args ...any) ([]feed.Subscription, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	var result []feed.Subscription
+	for rows.Next() {
+		sub, err := scanSQLiteSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan subscription: %w", err)
+		}
+		result = append(result, sub)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return result, nil
+}
+
+func scanSQLiteSubscription(row interface{ Scan(...any) error }) (feed.Subscription, error) {
+	var (
+		sub          feed.Subscription
+		seconds      int64
+		lastPolledAt sql.NullString
+		nextPollAt   string
+		createdAt    string
+	)
+	err := row.Scan(&sub.ID, &sub.SourceURL, &sub.Title, &sub.Folder, &seconds, &lastPolledAt,
+		&nextPollAt, &sub.LastError, &createdAt)
+	if err != nil {
+		return feed.Subscription{}, err
+	}
+
+	sub.Interval = time.Duration(seconds) * time.Second
+	if sub.NextPollAt, err = parseSQLiteTime(nextPollAt); err != nil {
+		return feed.Subscription{}, err
+	}
+	if sub.CreatedAt, err = parseSQLiteTime(createdAt); err != nil {
+		return feed.Subscription{}, err
+	}
+	if lastPolledAt.Valid {
+		if sub.LastPolledAt, err = parseSQLiteTime(lastPolledAt.String); err != nil {
+			return feed.Subscription{}, err
+		}
+	}
+	return sub, nil
+}
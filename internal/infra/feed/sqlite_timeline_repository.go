@@ -0,0 +1,96 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"rssreader/internal/domain/feed"
+)
+
+// Timeline merges the items of every stored feed, newest first. Among items sharing a
+// link, only the first stored one that matches the filters is listed.
+func (s *SQLiteStore) Timeline(ctx context.Context, query feed.TimelineQuery) ([]feed.Item, error) {
+	if query.FeedURLs != nil && len(query.FeedURLs) == 0 {
+		return nil, nil
+	}
+
+	filters := func(feeds, items string) (string, []any) {
+		var (
+			clauses []string
+			args    []any
+		)
+		if query.FeedURLs != nil {
+			clauses = append(clauses, feeds+".source_url IN (?"+strings.Repeat(", ?", len(query.FeedURLs)-1)+")")
+			for _, url := range query.FeedURLs {
+				args = append(args, url)
+			}
+		}
+		if query.Read != nil {
+			clauses = append(clauses, items+".read = ?")
+			args = append(args, *query.Read)
+		}
+		if query.Starred != nil {
+			clauses = append(clauses, items+".starred = ?")
+			args = append(args, *query.Starred)
+		}
+		if !query.From.IsZero() {
+			clauses = append(clauses, items+".published_at >= ?")
+			args = append(args, sqliteTime(query.From))
+		}
+		if !query.To.IsZero() {
+			clauses = append(clauses, items+".published_at < ?")
+			args = append(args, sqliteTime(query.To))
+		}
+		if len(clauses) == 0 {
+			return "1 = 1", nil
+		}
+		return strings.Join(clauses, " AND "), args
+	}
+
+	outer, args := filters("f", "i")
+	inner, innerArgs := filters("df", "d")
+	if !query.After.IsZero() {
+		outer += " AND (i.published_at, i.id) < (?, ?)"
+		args = append(args, sqliteTime(query.After.Time), query.After.ID)
+	}
+	args = append(append(args, innerArgs...), query.Limit)
+
+	sql := `
+SELECT ` + sqliteItemColumns + `
+FROM items i
+JOIN feeds f ON f.id = i.feed_id
+WHERE ` + outer + `
+  AND (i.link = '' OR NOT EXISTS (
+	SELECT 1
+	FROM items d
+	JOIN feeds df ON df.id = d.feed_id
+	WHERE d.link = i.link
+	  AND d.id < i.id
+	  AND ` + inner + `
+  ))
+ORDER BY i.published_at DESC, i.id DESC
+LIMIT ?;
+`
+
+	rows, err := s.db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list timeline: %w", err)
+	}
+	defer rows.Close()
+
+	var items []feed.Item
+	for rows.Next() {
+		item, err := scanSQLiteItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan item: %w", err)
+		}
+		items = append(items, item)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return items, nil
+}
@@ -0,0 +1,76 @@
+package feed
+
+import (
+	"slices"
+	"strings"
+)
+
+// titleFolds maps ASCII capitals and accented Latin letters to the lowercase letter
+// they sort under. Every store derives its title sort key from this table, in SQL or
+// in Go, so feeds sort the same way whatever the backend's case and locale rules.
+var titleFolds = buildTitleFolds()
+
+func buildTitleFolds() map[rune]rune {
+	folds := map[rune]rune{}
+	for r := 'A'; r <= 'Z'; r++ {
+		folds[r] = r + 'a' - 'A'
+	}
+	for base, letters := range map[rune]string{
+		'a': "ÁÀÂÃÄÅáàâãäå",
+		'c': "Çç",
+		'e': "ÉÈÊËéèêë",
+		'i': "ÍÌÎÏíìîï",
+		'n': "Ññ",
+		'o': "ÓÒÔÕÖØóòôõöø",
+		'u': "ÚÙÛÜúùûü",
+		'y': "Ýýÿ",
+	} {
+		for _, r := range letters {
+			folds[r] = base
+		}
+	}
+	return folds
+}
+
+// titleSortKey folds title with titleFolds; keys compare bytewise.
+func titleSortKey(title string) string {
+	return strings.Map(func(r rune) rune {
+		if folded, ok := titleFolds[r]; ok {
+			return folded
+		}
+		return r
+	}, title)
+}
+
+// postgresTitleKey is the SQL form of titleSortKey for column; translate leaves the
+// characters missing from the table alone, unlike lower().
+func postgresTitleKey(column string) string {
+	var from, to strings.Builder
+	for _, r := range sortedFolds() {
+		from.WriteRune(r)
+		to.WriteRune(titleFolds[r])
+	}
+	return "translate(" + column + ", '" + from.String() + "', '" + to.String() + "')"
+}
+
+// sqliteTitleKey is the SQL form of titleSortKey for column; SQLite's lower() only
+// folds ASCII, which leaves the accented letters to replace().
+func sqliteTitleKey(column string) string {
+	key := column
+	for _, r := range sortedFolds() {
+		if r < 0x80 {
+			continue
+		}
+		key = "replace(" + key + ", '" + string(r) + "', '" + string(titleFolds[r]) + "')"
+	}
+	return "lower(" + key + ")"
+}
+
+func sortedFolds() []rune {
+	runes := make([]rune, 0, len(titleFolds))
+	for r := range titleFolds {
+		runes = append(runes, r)
+	}
+	slices.Sort(runes)
+	return runes
+}
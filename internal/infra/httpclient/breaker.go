@@ -0,0 +1,212 @@
+package httpclient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+// ErrCircuitOpen is matched by errors returned while a host's circuit is open.
+var ErrCircuitOpen = errors.New("circuit open")
+
+// CircuitOpenError reports a request refused because its host is considered down.
+type CircuitOpenError struct {
+	Host    string
+	RetryAt time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit open for %s until %s", e.Host, e.RetryAt.Format(time.RFC3339))
+}
+
+func (e *CircuitOpenError) Unwrap() error {
+	return ErrCircuitOpen
+}
+
+// BreakerConfig tunes the circuit breaker; zero values select the defaults.
+type BreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures that opens a circuit (default 5).
+	FailureThreshold int
+	// Cooldown is how long an open circuit rejects requests before probing again (default 1m).
+	Cooldown time.Duration
+	// IdleTTL is how long a host is remembered after its last failure once no request
+	// is waiting on it (default 1h).
+	IdleTTL time.Duration
+	// MaxHosts caps the hosts tracked at once; past it the longest idle is forgotten
+	// (default 1000).
+	MaxHosts int
+}
+
+// Breaker is a per-host circuit breaker. After FailureThreshold consecutive failures
+// requests to the host fail fast until Cooldown elapses; then a single probe decides
+// whether the circuit closes again.
+type Breaker struct {
+	next  Client
+	cfg   BreakerConfig
+	clock func() time.Time
+
+	mu    sync.Mutex
+	hosts map[string]*hostCircuit
+}
+
+type hostCircuit struct {
+	state     string
+	failures  int
+	lastError string
+	openedAt  time.Time
+	retryAt   time.Time
+	failedAt  time.Time
+	probing   bool
+}
+
+// NewBreaker wraps next with a per-host circuit breaker.
+func NewBreaker(next Client, cfg BreakerConfig, clock func() time.Time) *Breaker {
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = 5
+	}
+	if cfg.Cooldown <= 0 {
+		cfg.Cooldown = time.Minute
+	}
+	if cfg.IdleTTL <= 0 {
+		cfg.IdleTTL = time.Hour
+	}
+	if cfg.MaxHosts <= 0 {
+		cfg.MaxHosts = 1000
+	}
+	if clock == nil {
+		clock = time.Now
+	}
+	return &Breaker{next: next, cfg: cfg, clock: clock, hosts: make(map[string]*hostCircuit)}
+}
+
+// Do sends the request unless the circuit for its host is open.
+func (b *Breaker) Do(req *http.Request) (*http.Response, error) {
+	host := strings.ToLower(req.URL.Host)
+	if err := b.admit(host); err != nil {
+		return nil, err
+	}
+
+	res, err := b.next.Do(req)
+
+	switch {
+	case req.Context().Err() != nil || errors.Is(err, ErrBlockedDestination):
+		// Not the host's fault; release a pending probe without judging the host.
+		b.record(host, nil, false)
+	case err != nil:
+		b.record(host, err, true)
+	case res.StatusCode >= 500 || res.StatusCode == http.StatusTooManyRequests:
+		b.record(host, errors.New("status "+strconv.Itoa(res.StatusCode)), true)
+	default:
+		b.record(host, nil, true)
+	}
+
+	return res, err
+}
+
+// HostStatuses returns the hosts with recent failures or a non-closed circuit.
+func (b *Breaker) HostStatuses() []feed.HostStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	statuses := make([]feed.HostStatus, 0, len(b.hosts))
+	for host, circuit := range b.hosts {
+		statuses = append(statuses, feed.HostStatus{
+			Host:                host,
+			State:               circuit.state,
+			ConsecutiveFailures: circuit.failures,
+			LastError:           circuit.lastError,
+			OpenedAt:            circuit.openedAt,
+			RetryAt:             circuit.retryAt,
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Host < statuses[j].Host })
+	return statuses
+}
+
+func (b *Breaker) admit(host string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	circuit, ok := b.hosts[host]
+	if !ok {
+		return nil
+	}
+
+	switch circuit.state {
+	case feed.HostStateOpen:
+		if b.clock().Before(circuit.retryAt) {
+			return &CircuitOpenError{Host: host, RetryAt: circuit.retryAt}
+		}
+		circuit.state = feed.HostStateHalfOpen
+		circuit.probing = true
+	case feed.HostStateHalfOpen:
+		if circuit.probing {
+			return &CircuitOpenError{Host: host, RetryAt: circuit.retryAt}
+		}
+		circuit.probing = true
+	}
+	return nil
+}
+
+// record stores the outcome of a request; judged is false when it says nothing about the host.
+func (b *Breaker) record(host string, failure error, judged bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	circuit, ok := b.hosts[host]
+	switch {
+	case !judged:
+		if ok {
+			circuit.probing = false
+		}
+	case failure == nil:
+		// Healthy hosts are forgotten so the map only tracks troubled ones.
+		delete(b.hosts, host)
+	default:
+		now := b.clock()
+		if !ok {
+			b.evict(now)
+			circuit = &hostCircuit{state: feed.HostStateClosed}
+			b.hosts[host] = circuit
+		}
+		circuit.failures++
+		circuit.lastError = failure.Error()
+		circuit.failedAt = now
+		circuit.probing = false
+		if circuit.state == feed.HostStateHalfOpen || circuit.failures >= b.cfg.FailureThreshold {
+			circuit.state = feed.HostStateOpen
+			circuit.openedAt = now
+			circuit.retryAt = now.Add(b.cfg.Cooldown)
+		}
+	}
+}
+
+// evict makes room for a new host: hosts idle for IdleTTL are forgotten, and while the
+// map is full the one idle the longest goes too. Hosts with a probe in flight are kept.
+// Callers hold b.mu.
+func (b *Breaker) evict(now time.Time) {
+	for host, circuit := range b.hosts {
+		if !circuit.probing && now.Sub(circuit.failedAt) >= b.cfg.IdleTTL {
+			delete(b.hosts, host)
+		}
+	}
+	for len(b.hosts) >= b.cfg.MaxHosts {
+		oldest := ""
+		for host, circuit := range b.hosts {
+			if !circuit.probing && (oldest == "" || circuit.failedAt.Before(b.hosts[oldest].failedAt)) {
+				oldest = host
+			}
+		}
+		if oldest == "" {
+			return
+		}
+		delete(b.hosts, oldest)
+	}
+}
@@ -0,0 +1,115 @@
+package httpclient_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/infra/httpclient"
+)
+
+type clientStub struct {
+	calls  int
+	status int
+	err    error
+}
+
+func (c *clientStub) Do(req *http.Request) (*http.Response, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &http.Response{StatusCode: c.status, Body: io.NopCloser(strings.NewReader(""))}, nil
+}
+
+func TestBreakerOpensAfterConsecutiveFailures(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	stub := &clientStub{err: errors.New("connection refused")}
+	breaker := httpclient.NewBreaker(stub, httpclient.BreakerConfig{FailureThreshold: 2, Cooldown: time.Minute}, func() time.Time { return now })
+
+	for i := 0; i < 2; i++ {
+		if _, err := doBreaker(breaker, "https://down.example.com/rss"); errors.Is(err, httpclient.ErrCircuitOpen) {
+			t.Fatalf("circuit opened too early on attempt %d", i+1)
+		}
+	}
+
+	_, err := doBreaker(breaker, "https://down.example.com/rss")
+	var open *httpclient.CircuitOpenError
+	if !errors.As(err, &open) || !errors.Is(err, httpclient.ErrCircuitOpen) {
+		t.Fatalf("expected circuit open error, got %v", err)
+	}
+	if stub.calls != 2 {
+		t.Errorf("expected open circuit to short-circuit, got %d upstream calls", stub.calls)
+	}
+	if !open.RetryAt.Equal(now.Add(time.Minute)) {
+		t.Errorf("unexpected retry at: %v", open.RetryAt)
+	}
+
+	if _, err := doBreaker(breaker, "https://other.example.com/rss"); errors.Is(err, httpclient.ErrCircuitOpen) {
+		t.Error("expected other hosts to be unaffected")
+	}
+
+	statuses := breaker.HostStatuses()
+	if len(statuses) != 2 || statuses[1].State != feed.HostStateClosed || statuses[0].Host != "down.example.com" || statuses[0].State != feed.HostStateOpen ||
+		statuses[0].ConsecutiveFailures != 2 || statuses[0].LastError == "" {
+		t.Fatalf("unexpected host statuses: %+v", statuses)
+	}
+}
+
+func TestBreakerProbesAfterCooldown(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	stub := &clientStub{status: http.StatusServiceUnavailable}
+	breaker := httpclient.NewBreaker(stub, httpclient.BreakerConfig{FailureThreshold: 1, Cooldown: time.Minute}, func() time.Time { return now })
+
+	doBreaker(breaker, "https://flaky.example.com/rss")
+
+	now = now.Add(time.Minute)
+	doBreaker(breaker, "https://flaky.example.com/rss")
+	if stub.calls != 2 {
+		t.Fatalf("expected a probe after cooldown, got %d calls", stub.calls)
+	}
+	if _, err := doBreaker(breaker, "https://flaky.example.com/rss"); !errors.Is(err, httpclient.ErrCircuitOpen) {
+		t.Fatalf("expected failed probe to reopen the circuit, got %v", err)
+	}
+
+	now = now.Add(time.Minute)
+	stub.status = http.StatusOK
+	if _, err := doBreaker(breaker, "https://flaky.example.com/rss"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if statuses := breaker.HostStatuses(); len(statuses) != 0 {
+		t.Fatalf("expected successful probe to close the circuit, got %+v", statuses)
+	}
+}
+
+func TestBreakerForgetsIdleHosts(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	stub := &clientStub{err: errors.New("connection refused")}
+	breaker := httpclient.NewBreaker(stub, httpclient.BreakerConfig{FailureThreshold: 1, IdleTTL: time.Hour, MaxHosts: 2}, func() time.Time { return now })
+
+	doBreaker(breaker, "https://a.example.com/rss")
+	now = now.Add(time.Minute)
+	doBreaker(breaker, "https://b.example.com/rss")
+	now = now.Add(time.Minute)
+	doBreaker(breaker, "https://c.example.com/rss")
+
+	statuses := breaker.HostStatuses()
+	if len(statuses) != 2 || statuses[0].Host != "b.example.com" || statuses[1].Host != "c.example.com" {
+		t.Fatalf("expected the longest idle host to make room, got %+v", statuses)
+	}
+
+	now = now.Add(time.Hour)
+	doBreaker(breaker, "https://d.example.com/rss")
+	if statuses := breaker.HostStatuses(); len(statuses) != 1 || statuses[0].Host != "d.example.com" {
+		t.Fatalf("expected idle hosts to be forgotten, got %+v", statuses)
+	}
+}
+
+func doBreaker(client httpclient.Client, url string) (*http.Response, error) {
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	return client.Do(req)
+}
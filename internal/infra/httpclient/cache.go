@@ -0,0 +1,50 @@
+package httpclient
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// freshnessLifetime returns how long a response stays fresh according to its
+// Cache-Control header, minus the Age already spent in upstream caches. The boolean
+// is false when the header does not state a lifetime; no-cache and no-store state zero.
+func freshnessLifetime(header http.Header) (time.Duration, bool) {
+	var (
+		maxAge, sharedMaxAge = -1, -1
+		noCache              bool
+	)
+	for _, directive := range strings.Split(strings.Join(header.Values("Cache-Control"), ","), ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "no-cache", "no-store":
+			noCache = true
+		case "max-age":
+			if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+				maxAge = seconds
+			}
+		case "s-maxage":
+			if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+				sharedMaxAge = seconds
+			}
+		}
+	}
+
+	switch {
+	case noCache:
+		return 0, true
+	case sharedMaxAge >= 0:
+		// The reader serves every client from one cache, so the shared lifetime applies.
+		maxAge = sharedMaxAge
+	case maxAge < 0:
+		return 0, false
+	}
+
+	lifetime := time.Duration(maxAge) * time.Second
+	if age, err := strconv.Atoi(strings.TrimSpace(header.Get("Age"))); err == nil && age > 0 {
+		lifetime -= time.Duration(age) * time.Second
+	}
+	return max(lifetime, 0), true
+}
@@ -13,6 +13,43 @@ type Client interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
+// DefaultMaxBodySize caps response bodies when no explicit limit is configured.
+const DefaultMaxBodySize int64 = 10 << 20
+
+// BodyTooLargeError reports a response body larger than the configured limit.
+type BodyTooLargeError struct {
+	Limit int64
+}
+
+func (e *BodyTooLargeError) Error() string {
+	return fmt.Sprintf("response body exceeds %d bytes", e.Limit)
+}
+
+// StatusError reports a non-2xx response that is not a 304 answer to a conditional request.
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status code: %d", e.StatusCode)
+}
+
+// Response is the outcome of a conditional GET request.
+type Response struct {
+	// Body streams the payload and must be closed by the caller. Reads fail with
+	// *BodyTooLargeError once the size limit is exceeded.
+	Body         io.ReadCloser
+	StatusCode   int
+	ETag         string
+	LastModified string
+	// NotModified is set when the server answered 304; Body is nil in that case.
+	NotModified bool
+	// MaxAge is the freshness lifetime stated by Cache-Control; HasMaxAge is false
+	// when the server stated none.
+	MaxAge    time.Duration
+	HasMaxAge bool
+}
+
 // NewDefault returns an http.Client with sane defaults.
 func NewDefault(timeout time.Duration) *http.Client {
 	if timeout == 0 {
@@ -22,28 +59,109 @@ func NewDefault(timeout time.Duration) *http.Client {
 	return &http.Client{Timeout: timeout}
 }
 
-// FetchBytes performs a GET request and returns the response body bytes.
+// FetchBytes performs a GET request and returns the response body bytes, up to DefaultMaxBodySize.
 func FetchBytes(ctx context.Context, client Client, url string) ([]byte, error) {
+	res, err := FetchConditional(ctx, client, url, "", "", DefaultMaxBodySize)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	return io.ReadAll(res.Body)
+}
+
+// FetchConditional performs a GET request, sending If-None-Match and If-Modified-Since
+// when the corresponding validators are provided. The body is limited to maxBodySize
+// bytes, or DefaultMaxBodySize when it is not positive.
+func FetchConditional(ctx context.Context, client Client, url, etag, lastModified string, maxBodySize int64) (*Response, error) {
+	if maxBodySize <= 0 {
+		maxBodySize = DefaultMaxBodySize
+	}
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	if lastModified != "" {
+		req.Header.Set("If-Modified-Since", lastModified)
+	}
 
 	res, err := client.Do(req)
 	if err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotModified && (etag != "" || lastModified != "") {
+		discard(res.Body)
+		maxAge, hasMaxAge := freshnessLifetime(res.Header)
+		return &Response{
+			StatusCode:   res.StatusCode,
+			ETag:         headerOr(res, "ETag", etag),
+			LastModified: headerOr(res, "Last-Modified", lastModified),
+			NotModified:  true,
+			MaxAge:       maxAge,
+			HasMaxAge:    hasMaxAge,
+		}, nil
+	}
 
 	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		io.Copy(io.Discard, res.Body)
-		return nil, fmt.Errorf("unexpected status code: %d", res.StatusCode)
+		discard(res.Body)
+		return nil, &StatusError{StatusCode: res.StatusCode}
 	}
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
+	if res.ContentLength > maxBodySize {
+		res.Body.Close()
+		return nil, &BodyTooLargeError{Limit: maxBodySize}
+	}
+
+	maxAge, hasMaxAge := freshnessLifetime(res.Header)
+	return &Response{
+		Body:         &limitedBody{body: res.Body, remaining: maxBodySize, limit: maxBodySize},
+		StatusCode:   res.StatusCode,
+		ETag:         res.Header.Get("ETag"),
+		LastModified: res.Header.Get("Last-Modified"),
+		MaxAge:       maxAge,
+		HasMaxAge:    hasMaxAge,
+	}, nil
+}
+
+// limitedBody fails with *BodyTooLargeError instead of silently truncating the payload.
+type limitedBody struct {
+	body      io.ReadCloser
+	remaining int64
+	limit     int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.remaining < 0 {
+		return 0, &BodyTooLargeError{Limit: b.limit}
+	}
+	// Read one byte past the limit to tell an exact-size body from an oversized one.
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
 	}
+	n, err := b.body.Read(p)
+	b.remaining -= int64(n)
+	if b.remaining < 0 {
+		return n + int(b.remaining), &BodyTooLargeError{Limit: b.limit}
+	}
+	return n, err
+}
 
-	return body, nil
+func (b *limitedBody) Close() error {
+	return b.body.Close()
+}
+
+func discard(body io.ReadCloser) {
+	io.Copy(io.Discard, io.LimitReader(body, 64<<10))
+	body.Close()
+}
+
+func headerOr(res *http.Response, key, fallback string) string {
+	if value := res.Header.Get(key); value != "" {
+		return value
+	}
+	return fallback
 }
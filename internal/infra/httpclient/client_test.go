@@ -0,0 +1,106 @@
+package httpclient_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"rssreader/internal/infra/httpclient"
+)
+
+func TestFetchConditionalLimitsBodySize(t *testing.T) {
+	payload := strings.Repeat("x", 64)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("chunked") {
+			// Flushing before writing hides the Content-Length from the client.
+			w.(http.Flusher).Flush()
+		}
+		io.WriteString(w, payload)
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		name   string
+		url    string
+		limit  int64
+		tooBig bool
+	}{
+		{name: "declared length", url: server.URL, limit: 32, tooBig: true},
+		{name: "streamed", url: server.URL + "?chunked=1", limit: 32, tooBig: true},
+		{name: "exact size", url: server.URL + "?chunked=1", limit: 64},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var body []byte
+			res, err := httpclient.FetchConditional(context.Background(), server.Client(), tc.url, "", "", tc.limit)
+			if err == nil {
+				body, err = io.ReadAll(res.Body)
+				res.Body.Close()
+			}
+
+			var tooLarge *httpclient.BodyTooLargeError
+			if tc.tooBig {
+				if !errors.As(err, &tooLarge) || tooLarge.Limit != tc.limit {
+					t.Fatalf("expected body too large error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(body) != payload {
+				t.Fatalf("expected full payload, got %d bytes", len(body))
+			}
+		})
+	}
+}
+
+func TestFetchConditionalReadsCacheLifetime(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, value := range r.URL.Query()["cc"] {
+			w.Header().Add("Cache-Control", value)
+		}
+		if age := r.URL.Query().Get("age"); age != "" {
+			w.Header().Set("Age", age)
+		}
+		io.WriteString(w, "<rss/>")
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		name   string
+		query  string
+		maxAge time.Duration
+		has    bool
+	}{
+		{name: "absent", query: ""},
+		{name: "max-age", query: "cc=public,+max-age=600", maxAge: 10 * time.Minute, has: true},
+		{name: "shared max-age wins", query: "cc=max-age=600&cc=s-maxage=60", maxAge: time.Minute, has: true},
+		{name: "age is subtracted", query: "cc=max-age=600&age=120", maxAge: 8 * time.Minute, has: true},
+		{name: "no-cache", query: "cc=no-cache,+max-age=600", has: true},
+		{name: "malformed", query: "cc=max-age=soon"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := httpclient.FetchConditional(context.Background(), server.Client(), server.URL+"?"+tc.query, "", "", 0)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			res.Body.Close()
+
+			if res.StatusCode != http.StatusOK {
+				t.Errorf("expected status 200, got %d", res.StatusCode)
+			}
+			if res.MaxAge != tc.maxAge || res.HasMaxAge != tc.has {
+				t.Errorf("expected max age %v (%v), got %v (%v)", tc.maxAge, tc.has, res.MaxAge, res.HasMaxAge)
+			}
+		})
+	}
+}
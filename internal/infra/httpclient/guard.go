@@ -0,0 +1,172 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrBlockedDestination is returned when a request targets a scheme or network address
+// that outbound fetches are not allowed to reach.
+var ErrBlockedDestination = errors.New("destination not allowed")
+
+// blockedPrefixes complements the net/netip predicates with special-purpose ranges. The
+// IPv6 transition ranges (NAT64, IPv4-compatible, 6to4 and Teredo) embed an IPv4 address
+// that may reach an internal host, so they are rejected outright.
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("::/96"),
+	netip.MustParsePrefix("2002::/16"),
+	netip.MustParsePrefix("2001::/32"),
+}
+
+// Guard restricts outbound requests to public http(s) destinations. Addresses are checked
+// on every connection, after DNS resolution, so a hostname cannot be rebound to an
+// internal address between validation and connect.
+type Guard struct {
+	allowHosts    map[string]bool
+	allowSuffixes []string
+	allowPrefixes []netip.Prefix
+}
+
+// NewGuard builds a Guard whose allowlist may contain host names, ".example.com" style
+// domain suffixes, IP addresses and CIDR ranges that bypass the address checks.
+func NewGuard(allow []string) (*Guard, error) {
+	g := &Guard{allowHosts: make(map[string]bool)}
+	for _, raw := range allow {
+		entry := strings.ToLower(strings.TrimSpace(raw))
+		switch {
+		case entry == "":
+		case strings.Contains(entry, "/"):
+			prefix, err := netip.ParsePrefix(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid allowlist range %q: %w", raw, err)
+			}
+			g.allowPrefixes = append(g.allowPrefixes, prefix.Masked())
+		case strings.HasPrefix(entry, "."):
+			g.allowSuffixes = append(g.allowSuffixes, entry)
+		default:
+			if addr, err := netip.ParseAddr(entry); err == nil {
+				g.allowPrefixes = append(g.allowPrefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+				continue
+			}
+			g.allowHosts[entry] = true
+		}
+	}
+	return g, nil
+}
+
+// NewGuarded returns an http.Client with the NewDefault timeout whose connections and
+// redirect hops are all validated by guard.
+func NewGuarded(timeout time.Duration, guard *Guard) *http.Client {
+	client := NewDefault(timeout)
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would connect on our behalf and bypass the address checks.
+	transport.Proxy = nil
+	transport.DialContext = guard.DialContext
+
+	client.Transport = guardedTransport{guard: guard, next: transport}
+	return client
+}
+
+// CheckScheme rejects anything but absolute http and https URLs.
+func (g *Guard) CheckScheme(scheme, host string) error {
+	if scheme != "http" && scheme != "https" {
+		return fmt.Errorf("%w: scheme %q", ErrBlockedDestination, scheme)
+	}
+	if host == "" {
+		return fmt.Errorf("%w: missing host", ErrBlockedDestination)
+	}
+	return nil
+}
+
+// DialContext connects to address, refusing blocked IPs unless the host is allowlisted.
+func (g *Guard) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	if !g.hostAllowed(host) {
+		dialer.Control = g.control
+	}
+	return dialer.DialContext(ctx, network, address)
+}
+
+// control runs after name resolution with the concrete address being connected to.
+func (g *Guard) control(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return fmt.Errorf("%w: unresolved address %q", ErrBlockedDestination, host)
+	}
+	return g.CheckAddr(addr)
+}
+
+// CheckAddr rejects loopback, link-local, private, multicast and other non-public addresses
+// that are not explicitly allowlisted.
+func (g *Guard) CheckAddr(addr netip.Addr) error {
+	addr = addr.Unmap()
+	for _, prefix := range g.allowPrefixes {
+		if prefix.Contains(addr) {
+			return nil
+		}
+	}
+
+	blocked := addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast()
+	for _, prefix := range blockedPrefixes {
+		blocked = blocked || prefix.Contains(addr)
+	}
+	if blocked {
+		return fmt.Errorf("%w: address %s", ErrBlockedDestination, addr)
+	}
+	return nil
+}
+
+func (g *Guard) hostAllowed(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if g.allowHosts[host] {
+		return true
+	}
+	for _, suffix := range g.allowSuffixes {
+		if strings.HasSuffix(host, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// guardedTransport validates the scheme of every request, including each redirect hop,
+// before handing it to the guarded dialer.
+type guardedTransport struct {
+	guard *Guard
+	next  http.RoundTripper
+}
+
+func (t guardedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.guard.CheckScheme(req.URL.Scheme, req.URL.Hostname()); err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,115 @@
+package httpclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"rssreader/internal/infra/httpclient"
+)
+
+func TestGuardCheckAddr(t *testing.T) {
+	guard, err := httpclient.NewGuard([]string{"10.1.0.0/16", "192.168.1.10"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cases := map[string]bool{
+		"93.184.216.34":                        true,
+		"2606:4700::1111":                      true,
+		"127.0.0.1":                            false,
+		"::1":                                  false,
+		"169.254.169.254":                      false,
+		"10.0.0.1":                             false,
+		"172.16.5.4":                           false,
+		"192.168.1.11":                         false,
+		"100.64.0.1":                           false,
+		"224.0.0.1":                            false,
+		"0.0.0.0":                              false,
+		"fe80::1":                              false,
+		"fd00::1":                              false,
+		"::ffff:127.0.0.1":                     false,
+		"::7f00:1":                             false,
+		"::a00:1":                              false,
+		"2002:7f00:1::1":                       false,
+		"2002:a9fe:a9fe::":                     false,
+		"2001:0:4136:e378:8000:63bf:3fff:fdd2": false,
+		"2001:4860:4860::8888":                 true,
+		"10.1.2.3":                             true,
+		"192.168.1.10":                         true,
+	}
+	for raw, allowed := range cases {
+		err := guard.CheckAddr(netip.MustParseAddr(raw))
+		if allowed && err != nil {
+			t.Errorf("%s: expected address to be allowed, got %v", raw, err)
+		}
+		if !allowed && !errors.Is(err, httpclient.ErrBlockedDestination) {
+			t.Errorf("%s: expected address to be blocked, got %v", raw, err)
+		}
+	}
+}
+
+func TestGuardedClientBlocksInternalDestinations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("secret"))
+	}))
+	defer server.Close()
+
+	guard, _ := httpclient.NewGuard(nil)
+	client := httpclient.NewGuarded(time.Second, guard)
+
+	for _, url := range []string{
+		server.URL,
+		strings.Replace(server.URL, "127.0.0.1", "localhost", 1),
+		"file:///etc/passwd",
+		"gopher://example.com/",
+	} {
+		_, err := httpclient.FetchBytes(context.Background(), client, url)
+		if !errors.Is(err, httpclient.ErrBlockedDestination) {
+			t.Errorf("%s: expected blocked destination error, got %v", url, err)
+		}
+	}
+}
+
+func TestGuardedClientHonoursAllowlist(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("intranet"))
+	}))
+	defer server.Close()
+
+	guard, _ := httpclient.NewGuard([]string{"localhost"})
+	client := httpclient.NewGuarded(time.Second, guard)
+
+	body, err := httpclient.FetchBytes(context.Background(), client, strings.Replace(server.URL, "127.0.0.1", "localhost", 1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(body) != "intranet" {
+		t.Fatalf("unexpected body: %q", body)
+	}
+}
+
+func TestGuardedClientRechecksRedirects(t *testing.T) {
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("secret"))
+	}))
+	defer internal.Close()
+
+	redirector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, internal.URL, http.StatusFound)
+	}))
+	defer redirector.Close()
+
+	guard, _ := httpclient.NewGuard([]string{"localhost"})
+	client := httpclient.NewGuarded(time.Second, guard)
+
+	_, err := httpclient.FetchBytes(context.Background(), client, strings.Replace(redirector.URL, "127.0.0.1", "localhost", 1))
+	if !errors.Is(err, httpclient.ErrBlockedDestination) {
+		t.Fatalf("expected redirect to internal address to be blocked, got %v", err)
+	}
+}
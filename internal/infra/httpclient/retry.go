@@ -0,0 +1,118 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryConfig tunes the retrying client; zero values select the defaults.
+type RetryConfig struct {
+	// MaxAttempts is the total number of tries per request (default 3).
+	MaxAttempts int
+	// BaseDelay is the backoff before the first retry, doubled on each attempt (default 500ms).
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff and the honoured Retry-After (default 30s).
+	MaxDelay time.Duration
+}
+
+// RetryingClient retries idempotent requests that failed with a network error or a
+// transient status, honouring Retry-After and otherwise backing off exponentially
+// with full jitter.
+type RetryingClient struct {
+	next Client
+	cfg  RetryConfig
+	// sleep waits for d or until ctx is done; replaced in tests.
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// NewRetrying wraps next with retries.
+func NewRetrying(next Client, cfg RetryConfig) *RetryingClient {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 3
+	}
+	if cfg.BaseDelay <= 0 {
+		cfg.BaseDelay = 500 * time.Millisecond
+	}
+	if cfg.MaxDelay <= 0 {
+		cfg.MaxDelay = 30 * time.Second
+	}
+	return &RetryingClient{next: next, cfg: cfg, sleep: sleepContext}
+}
+
+// Do sends the request, retrying GET and HEAD requests on transient failures.
+func (c *RetryingClient) Do(req *http.Request) (*http.Response, error) {
+	idempotent := req.Method == http.MethodGet || req.Method == http.MethodHead
+	for attempt := 1; ; attempt++ {
+		res, err := c.next.Do(req)
+		if !idempotent || attempt >= c.cfg.MaxAttempts || req.Context().Err() != nil || !retryable(res, err) {
+			return res, err
+		}
+
+		delay := c.backoff(attempt)
+		if res != nil {
+			if wait, ok := retryAfter(res.Header.Get("Retry-After"), time.Now()); ok {
+				if wait > c.cfg.MaxDelay {
+					// The publisher asked for a longer pause than we are willing to hold the request.
+					return res, nil
+				}
+				delay = wait
+			}
+			io.Copy(io.Discard, io.LimitReader(res.Body, 64<<10))
+			res.Body.Close()
+		}
+
+		if err := c.sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (c *RetryingClient) backoff(attempt int) time.Duration {
+	ceiling := c.cfg.BaseDelay << (attempt - 1)
+	if ceiling <= 0 || ceiling > c.cfg.MaxDelay {
+		ceiling = c.cfg.MaxDelay
+	}
+	return rand.N(ceiling) + 1
+}
+
+func retryable(res *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, ErrBlockedDestination)
+	}
+	switch res.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusInternalServerError,
+		http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryAfter parses a Retry-After header given in seconds or as an HTTP date.
+func retryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(at.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,87 @@
+package httpclient_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"rssreader/internal/infra/httpclient"
+)
+
+func TestRetryingClientRetriesTransientStatuses(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := httpclient.NewRetrying(server.Client(), httpclient.RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond})
+	res := do(t, client, http.MethodGet, server.URL)
+
+	if res.StatusCode != http.StatusOK || calls.Load() != 3 {
+		t.Fatalf("expected success on third attempt, got %d after %d calls", res.StatusCode, calls.Load())
+	}
+}
+
+func TestRetryingClientLeavesPermanentFailuresAlone(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if strings.HasSuffix(r.URL.Path, "/missing") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := httpclient.NewRetrying(server.Client(), httpclient.RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond})
+
+	if res := do(t, client, http.MethodGet, server.URL+"/missing"); res.StatusCode != http.StatusNotFound || calls.Load() != 1 {
+		t.Errorf("expected 404 without retries, got %d after %d calls", res.StatusCode, calls.Load())
+	}
+
+	calls.Store(0)
+	if res := do(t, client, http.MethodPost, server.URL); res.StatusCode != http.StatusBadGateway || calls.Load() != 1 {
+		t.Errorf("expected non-idempotent request not to be retried, got %d calls", calls.Load())
+	}
+}
+
+func TestRetryingClientGivesUpOnLongRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := httpclient.NewRetrying(server.Client(), httpclient.RetryConfig{MaxAttempts: 3, MaxDelay: time.Second})
+	res := do(t, client, http.MethodGet, server.URL)
+
+	if res.StatusCode != http.StatusTooManyRequests || calls.Load() != 1 {
+		t.Fatalf("expected 429 to be returned immediately, got %d after %d calls", res.StatusCode, calls.Load())
+	}
+}
+
+func do(t *testing.T, client httpclient.Client, method, url string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	res.Body.Close()
+	return res
+}
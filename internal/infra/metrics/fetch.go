@@ -0,0 +1,134 @@
+package metrics
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+
+	"rssreader/internal/domain/feed"
+)
+
+// Fetch outcomes besides the feed.FetchError* classes reported for failures.
+const (
+	OutcomeOK          = "ok"
+	OutcomeNotModified = "not_modified"
+)
+
+// Host labels shared by downloads that do not get a series of their own.
+const (
+	// HostOther groups the hosts without a subscription or catalog entry.
+	HostOther = "other"
+	// HostUnreached groups the attempts rejected before or while connecting, whatever
+	// their URL; per-host reachability is reported by the circuit breakers.
+	HostUnreached = "unreached"
+)
+
+// Hosts is the set of publisher hosts labelled by name in the fetch metrics, which
+// keeps the number of series bounded whatever URLs clients ask for. It is safe for
+// concurrent use.
+type Hosts struct {
+	mu    sync.RWMutex
+	known map[string]bool
+}
+
+// NewHosts returns an empty set.
+func NewHosts() *Hosts {
+	return &Hosts{known: make(map[string]bool)}
+}
+
+// Set replaces the known hosts with those of the feed URLs.
+func (h *Hosts) Set(urls []string) {
+	known := make(map[string]bool, len(urls))
+	for _, raw := range urls {
+		if host := hostOf(raw); host != "" {
+			known[host] = true
+		}
+	}
+
+	h.mu.Lock()
+	h.known = known
+	h.mu.Unlock()
+}
+
+// label returns the host label of the attempt.
+func (h *Hosts) label(attempt feed.FetchAttempt) string {
+	switch attempt.ErrorClass {
+	case feed.FetchErrorInvalid, feed.FetchErrorUnreachable:
+		return HostUnreached
+	}
+
+	host := hostOf(attempt.SourceURL)
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if host == "" || !h.known[host] {
+		return HostOther
+	}
+	return host
+}
+
+// FetchMetrics exports feed downloads per publisher host. It satisfies
+// fetchfeed.Observer.
+type FetchMetrics struct {
+	hosts         *Hosts
+	attempts      *CounterVec
+	duration      *HistogramVec
+	bytes         *CounterVec
+	fallbacks     *CounterVec
+	parseFailures *CounterVec
+}
+
+// NewFetchMetrics registers the download metrics in reg. Only the hosts in hosts are
+// labelled by name; a nil set reports every download under HostOther.
+func NewFetchMetrics(reg *Registry, hosts *Hosts) *FetchMetrics {
+	if hosts == nil {
+		hosts = NewHosts()
+	}
+	return &FetchMetrics{
+		hosts: hosts,
+		attempts: reg.NewCounterVec("rssreader_fetch_attempts_total",
+			"Feed downloads by publisher host and outcome.", "host", "outcome"),
+		duration: reg.NewHistogramVec("rssreader_fetch_duration_seconds",
+			"Time spent downloading and parsing feeds.", DefaultBuckets, "host", "outcome"),
+		bytes: reg.NewCounterVec("rssreader_fetch_bytes_total",
+			"Feed payload bytes read by publisher host and outcome.", "host", "outcome"),
+		fallbacks: reg.NewCounterVec("rssreader_fetch_fallbacks_total",
+			"Failed downloads answered with the stored snapshot.", "host"),
+		parseFailures: reg.NewCounterVec("rssreader_fetch_parse_failures_total",
+			"Downloaded payloads that could not be parsed as a feed.", "host"),
+	}
+}
+
+// ObserveFetch records a completed download attempt.
+func (m *FetchMetrics) ObserveFetch(attempt feed.FetchAttempt, servedStale bool) {
+	host := m.hosts.label(attempt)
+	outcome := fetchOutcome(attempt)
+
+	m.attempts.Inc(host, outcome)
+	m.duration.Observe(attempt.Duration.Seconds(), host, outcome)
+	m.bytes.Add(float64(attempt.Bytes), host, outcome)
+	if servedStale {
+		m.fallbacks.Inc(host)
+	}
+	if attempt.ErrorClass == feed.FetchErrorUnparseable {
+		m.parseFailures.Inc(host)
+	}
+}
+
+func fetchOutcome(attempt feed.FetchAttempt) string {
+	switch {
+	case attempt.Failed():
+		return attempt.ErrorClass
+	case attempt.StatusCode == 304:
+		return OutcomeNotModified
+	default:
+		return OutcomeOK
+	}
+}
+
+func hostOf(rawURL string) string {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(parsed.Hostname())
+}
@@ -0,0 +1,71 @@
+package metrics_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/infra/metrics"
+)
+
+func TestFetchMetricsObservesAttempts(t *testing.T) {
+	reg := metrics.NewRegistry()
+	hosts := metrics.NewHosts()
+	hosts.Set([]string{"https://Example.com/rss", "https://down.example.com/rss", "not a url"})
+	observer := metrics.NewFetchMetrics(reg, hosts)
+
+	observer.ObserveFetch(feed.FetchAttempt{SourceURL: "https://Example.com/rss", StatusCode: 200, Bytes: 512, Duration: time.Second}, false)
+	observer.ObserveFetch(feed.FetchAttempt{SourceURL: "https://example.com/rss", StatusCode: 304}, false)
+	observer.ObserveFetch(feed.FetchAttempt{SourceURL: "https://example.com/rss", StatusCode: 200, Bytes: 7, ErrorClass: feed.FetchErrorUnparseable}, false)
+	observer.ObserveFetch(feed.FetchAttempt{SourceURL: "https://down.example.com/rss", StatusCode: 503, ErrorClass: feed.FetchErrorStatus}, true)
+
+	body := scrape(t, reg)
+	for _, want := range []string{
+		`rssreader_fetch_attempts_total{host="example.com",outcome="ok"} 1`,
+		`rssreader_fetch_attempts_total{host="example.com",outcome="not_modified"} 1`,
+		`rssreader_fetch_attempts_total{host="example.com",outcome="unparseable"} 1`,
+		`rssreader_fetch_attempts_total{host="down.example.com",outcome="status"} 1`,
+		`rssreader_fetch_bytes_total{host="example.com",outcome="ok"} 512`,
+		`rssreader_fetch_duration_seconds_sum{host="example.com",outcome="ok"} 1`,
+		`rssreader_fetch_fallbacks_total{host="down.example.com"} 1`,
+		`rssreader_fetch_parse_failures_total{host="example.com"} 1`,
+	} {
+		if !strings.Contains(body, want+"\n") {
+			t.Errorf("expected %q in:\n%s", want, body)
+		}
+	}
+}
+
+func TestFetchMetricsBoundsHostLabels(t *testing.T) {
+	reg := metrics.NewRegistry()
+	hosts := metrics.NewHosts()
+	hosts.Set([]string{"https://example.com/rss"})
+	observer := metrics.NewFetchMetrics(reg, hosts)
+
+	observer.ObserveFetch(feed.FetchAttempt{SourceURL: "https://one.example.org/rss", StatusCode: 200}, false)
+	observer.ObserveFetch(feed.FetchAttempt{SourceURL: "https://two.example.org/rss", StatusCode: 200}, false)
+	observer.ObserveFetch(feed.FetchAttempt{SourceURL: "http://169.254.169.254/", ErrorClass: feed.FetchErrorInvalid}, false)
+	observer.ObserveFetch(feed.FetchAttempt{SourceURL: "https://example.com/rss", ErrorClass: feed.FetchErrorUnreachable}, true)
+
+	// Hosts dropped from the set stop getting their own series.
+	hosts.Set(nil)
+	observer.ObserveFetch(feed.FetchAttempt{SourceURL: "https://example.com/rss", StatusCode: 200}, false)
+
+	body := scrape(t, reg)
+	for _, want := range []string{
+		`rssreader_fetch_attempts_total{host="other",outcome="ok"} 3`,
+		`rssreader_fetch_attempts_total{host="unreached",outcome="invalid"} 1`,
+		`rssreader_fetch_attempts_total{host="unreached",outcome="unreachable"} 1`,
+		`rssreader_fetch_fallbacks_total{host="unreached"} 1`,
+	} {
+		if !strings.Contains(body, want+"\n") {
+			t.Errorf("expected %q in:\n%s", want, body)
+		}
+	}
+	for _, leaked := range []string{"one.example.org", "169.254.169.254", `host="example.com"`} {
+		if strings.Contains(body, leaked) {
+			t.Errorf("expected %q not to be a label in:\n%s", leaked, body)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Instrument returns middleware counting requests and timing responses per method,
+// route and status. The route is the http.ServeMux pattern that matched, so
+// requests for /api/feeds/1 and /api/feeds/2 share a series; requests no pattern
+// matched are reported as "unmatched", and non-standard methods as "OTHER".
+func Instrument(reg *Registry) func(http.Handler) http.Handler {
+	requests := reg.NewCounterVec("rssreader_http_requests_total",
+		"HTTP requests by method, route and status.", "method", "route", "status")
+	duration := reg.NewHistogramVec("rssreader_http_request_duration_seconds",
+		"Time spent answering HTTP requests.", DefaultBuckets, "method", "route", "status")
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			started := time.Now()
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+
+			labels := []string{methodOf(r), routeOf(r), strconv.Itoa(rec.status)}
+			requests.Inc(labels...)
+			duration.Observe(time.Since(started).Seconds(), labels...)
+		})
+	}
+}
+
+// methodOf keeps the standard methods and folds the rest, which clients may pick
+// freely, into a single label.
+func methodOf(r *http.Request) string {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
+		http.MethodDelete, http.MethodConnect, http.MethodOptions, http.MethodTrace:
+		return r.Method
+	default:
+		return "OTHER"
+	}
+}
+
+// routeOf reads the pattern the mux stored on the request, without its method,
+// which is a label of its own.
+func routeOf(r *http.Request) string {
+	if r.Pattern == "" {
+		return "unmatched"
+	}
+	if _, path, ok := strings.Cut(r.Pattern, " "); ok {
+		return path
+	}
+	return r.Pattern
+}
+
+// statusRecorder remembers the status code written by the wrapped handler.
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(p []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(p)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,52 @@
+package metrics_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"rssreader/internal/infra/metrics"
+)
+
+func TestInstrumentLabelsRequestsByRoute(t *testing.T) {
+	reg := metrics.NewRegistry()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/feeds/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("id") == "404" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("{}"))
+	})
+	handler := metrics.Instrument(reg)(mux)
+
+	for _, path := range []string{"/api/feeds/1", "/api/feeds/2", "/api/feeds/404", "/missing"} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+	for _, method := range []string{"BREW", "PROPFIND"} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(method, "/missing", nil))
+	}
+
+	body := scrape(t, reg)
+	for _, want := range []string{
+		`rssreader_http_requests_total{method="GET",route="/api/feeds/{id}",status="200"} 2`,
+		`rssreader_http_requests_total{method="GET",route="/api/feeds/{id}",status="404"} 1`,
+		`rssreader_http_requests_total{method="GET",route="unmatched",status="404"} 1`,
+		`rssreader_http_requests_total{method="OTHER",route="unmatched",status="404"} 2`,
+		`rssreader_http_request_duration_seconds_count{method="GET",route="/api/feeds/{id}",status="200"} 2`,
+	} {
+		if !strings.Contains(body, want+"\n") {
+			t.Errorf("expected %q in:\n%s", want, body)
+		}
+	}
+}
+
+func scrape(t *testing.T, reg *metrics.Registry) string {
+	t.Helper()
+	var b strings.Builder
+	if _, err := reg.WriteTo(&b); err != nil {
+		t.Fatalf("WriteTo() unexpected error: %v", err)
+	}
+	return b.String()
+}
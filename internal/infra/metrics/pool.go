@@ -0,0 +1,36 @@
+package metrics
+
+import "github.com/jackc/pgx/v5/pgxpool"
+
+// RegisterPool exports the statistics of a PostgreSQL connection pool, read on
+// every scrape.
+func RegisterPool(reg *Registry, pool *pgxpool.Pool) {
+	stat := func(read func(*pgxpool.Stat) float64) func() float64 {
+		return func() float64 { return read(pool.Stat()) }
+	}
+
+	reg.NewGaugeFunc("rssreader_db_pool_connections",
+		"Open connections in the database pool.",
+		stat(func(s *pgxpool.Stat) float64 { return float64(s.TotalConns()) }))
+	reg.NewGaugeFunc("rssreader_db_pool_idle_connections",
+		"Idle connections in the database pool.",
+		stat(func(s *pgxpool.Stat) float64 { return float64(s.IdleConns()) }))
+	reg.NewGaugeFunc("rssreader_db_pool_acquired_connections",
+		"Connections currently in use.",
+		stat(func(s *pgxpool.Stat) float64 { return float64(s.AcquiredConns()) }))
+	reg.NewGaugeFunc("rssreader_db_pool_max_connections",
+		"Maximum size of the database pool.",
+		stat(func(s *pgxpool.Stat) float64 { return float64(s.MaxConns()) }))
+	reg.NewCounterFunc("rssreader_db_pool_acquires_total",
+		"Connections acquired from the pool.",
+		stat(func(s *pgxpool.Stat) float64 { return float64(s.AcquireCount()) }))
+	reg.NewCounterFunc("rssreader_db_pool_acquire_seconds_total",
+		"Time spent waiting to acquire connections.",
+		stat(func(s *pgxpool.Stat) float64 { return s.AcquireDuration().Seconds() }))
+	reg.NewCounterFunc("rssreader_db_pool_empty_acquires_total",
+		"Acquires that waited because the pool had no idle connection.",
+		stat(func(s *pgxpool.Stat) float64 { return float64(s.EmptyAcquireCount()) }))
+	reg.NewCounterFunc("rssreader_db_pool_canceled_acquires_total",
+		"Acquires abandoned because their context was cancelled.",
+		stat(func(s *pgxpool.Stat) float64 { return float64(s.CanceledAcquireCount()) }))
+}
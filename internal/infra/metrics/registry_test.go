@@ -0,0 +1,60 @@
+package metrics_test
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"rssreader/internal/infra/metrics"
+)
+
+func TestRegistryWritesTextFormat(t *testing.T) {
+	reg := metrics.NewRegistry()
+	requests := reg.NewCounterVec("app_requests_total", "Requests served.", "route", "status")
+	latency := reg.NewHistogramVec("app_latency_seconds", "Request latency.", []float64{0.1, 1}, "route")
+	reg.NewGaugeFunc("app_queue_depth", "Queued jobs.", func() float64 { return 3 })
+
+	requests.Inc("/feeds", "200")
+	requests.Add(2, "/feeds", "200")
+	requests.Inc(`/say "hi"`, "500")
+	latency.Observe(0.05, "/feeds")
+	latency.Observe(0.5, "/feeds")
+	latency.Observe(4, "/feeds")
+
+	rec := httptest.NewRecorder()
+	reg.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	want := `# HELP app_latency_seconds Request latency.
+# TYPE app_latency_seconds histogram
+app_latency_seconds_bucket{route="/feeds",le="0.1"} 1
+app_latency_seconds_bucket{route="/feeds",le="1"} 2
+app_latency_seconds_bucket{route="/feeds",le="+Inf"} 3
+app_latency_seconds_sum{route="/feeds"} 4.55
+app_latency_seconds_count{route="/feeds"} 3
+# HELP app_queue_depth Queued jobs.
+# TYPE app_queue_depth gauge
+app_queue_depth 3
+# HELP app_requests_total Requests served.
+# TYPE app_requests_total counter
+app_requests_total{route="/feeds",status="200"} 3
+app_requests_total{route="/say \"hi\"",status="500"} 1
+`
+	if got := rec.Body.String(); got != want {
+		t.Errorf("unexpected exposition:\n%s\nwant:\n%s", got, want)
+	}
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain; version=0.0.4") {
+		t.Errorf("unexpected content type %q", ct)
+	}
+}
+
+func TestRegistryRejectsDuplicateNames(t *testing.T) {
+	reg := metrics.NewRegistry()
+	reg.NewCounterVec("app_requests_total", "Requests served.")
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected registering a name twice to panic")
+		}
+	}()
+	reg.NewGaugeFunc("app_requests_total", "Requests served.", func() float64 { return 0 })
+}
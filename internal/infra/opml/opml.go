@@ -0,0 +1,223 @@
+package opml
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html/charset"
+)
+
+// FolderSeparator joins the titles of nested folder outlines into a folder path.
+const FolderSeparator = "/"
+
+// Document is an OPML 2.0 document.
+type Document struct {
+	Title       string
+	DateCreated time.Time
+	Outlines    []Outline
+}
+
+// Outline is a single OPML outline: either a feed (XMLURL set) or a folder grouping other outlines.
+type Outline struct {
+	Text     string    `xml:"text,attr"`
+	Title    string    `xml:"title,attr,omitempty"`
+	Type     string    `xml:"type,attr,omitempty"`
+	XMLURL   string    `xml:"xmlUrl,attr,omitempty"`
+	HTMLURL  string    `xml:"htmlUrl,attr,omitempty"`
+	Outlines []Outline `xml:"outline"`
+}
+
+// Entry is a feed outline flattened together with the folder path it was nested in.
+type Entry struct {
+	Title   string
+	XMLURL  string
+	HTMLURL string
+	Folder  string
+}
+
+type opmlXML struct {
+	XMLName xml.Name `xml:"opml"`
+	Version string   `xml:"version,attr"`
+	Head    headXML  `xml:"head"`
+	Body    bodyXML  `xml:"body"`
+}
+
+type headXML struct {
+	Title       string `xml:"title,omitempty"`
+	DateCreated string `xml:"dateCreated,omitempty"`
+}
+
+type bodyXML struct {
+	Outlines []Outline `xml:"outline"`
+}
+
+// UnmarshalXML decodes outline attributes case-insensitively, since exporters disagree on xmlUrl casing.
+func (o *Outline) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		switch strings.ToLower(attr.Name.Local) {
+		case "text":
+			o.Text = attr.Value
+		case "title":
+			o.Title = attr.Value
+		case "type":
+			o.Type = attr.Value
+		case "xmlurl":
+			o.XMLURL = attr.Value
+		case "htmlurl":
+			o.HTMLURL = attr.Value
+		}
+	}
+
+	var children struct {
+		Outlines []Outline `xml:"outline"`
+	}
+	if err := d.DecodeElement(&children, &start); err != nil {
+		return err
+	}
+	o.Outlines = children.Outlines
+	return nil
+}
+
+// Parse reads an OPML document.
+func Parse(r io.Reader) (*Document, error) {
+	var raw opmlXML
+	decoder := xml.NewDecoder(r)
+	decoder.CharsetReader = charset.NewReaderLabel
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("decode opml: %w", err)
+	}
+	if raw.XMLName.Local != "opml" {
+		return nil, errors.New("decode opml: missing opml root element")
+	}
+
+	doc := &Document{
+		Title:    strings.TrimSpace(raw.Head.Title),
+		Outlines: raw.Body.Outlines,
+	}
+	if created, err := time.Parse(time.RFC1123Z, strings.TrimSpace(raw.Head.DateCreated)); err == nil {
+		doc.DateCreated = created
+	} else if created, err := time.Parse(time.RFC1123, strings.TrimSpace(raw.Head.DateCreated)); err == nil {
+		doc.DateCreated = created
+	}
+
+	return doc, nil
+}
+
+// Write encodes the document as OPML 2.0.
+func Write(w io.Writer, doc Document) error {
+	raw := opmlXML{
+		Version: "2.0",
+		Head:    headXML{Title: doc.Title},
+		Body:    bodyXML{Outlines: doc.Outlines},
+	}
+	if !doc.DateCreated.IsZero() {
+		raw.Head.DateCreated = doc.DateCreated.Format(time.RFC1123Z)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(raw); err != nil {
+		return fmt.Errorf("encode opml: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// Entries flattens the document into its feed outlines, in document order.
+func (d *Document) Entries() []Entry {
+	var entries []Entry
+	var walk func(outlines []Outline, path []string)
+	walk = func(outlines []Outline, path []string) {
+		for _, outline := range outlines {
+			title := strings.TrimSpace(outline.Title)
+			if title == "" {
+				title = strings.TrimSpace(outline.Text)
+			}
+
+			if xmlURL := strings.TrimSpace(outline.XMLURL); xmlURL != "" {
+				entries = append(entries, Entry{
+					Title:   title,
+					XMLURL:  xmlURL,
+					HTMLURL: strings.TrimSpace(outline.HTMLURL),
+					Folder:  strings.Join(path, FolderSeparator),
+				})
+				walk(outline.Outlines, path)
+				continue
+			}
+
+			if title == "" {
+				walk(outline.Outlines, path)
+				continue
+			}
+			walk(outline.Outlines, append(path[:len(path):len(path)], title))
+		}
+	}
+	walk(d.Outlines, nil)
+	return entries
+}
+
+// FromEntries builds a document nesting the entries under folder outlines.
+// Folders are sorted by name and entries keep their relative order.
+func FromEntries(title string, created time.Time, entries []Entry) Document {
+	root := &folderNode{}
+	for _, entry := range entries {
+		node := root
+		for _, name := range splitFolder(entry.Folder) {
+			node = node.child(name)
+		}
+		node.feeds = append(node.feeds, Outline{
+			Text:    entry.Title,
+			Title:   entry.Title,
+			Type:    "rss",
+			XMLURL:  entry.XMLURL,
+			HTMLURL: entry.HTMLURL,
+		})
+	}
+
+	return Document{Title: title, DateCreated: created, Outlines: root.outlines()}
+}
+
+type folderNode struct {
+	name     string
+	children []*folderNode
+	feeds    []Outline
+}
+
+func (n *folderNode) child(name string) *folderNode {
+	for _, child := range n.children {
+		if child.name == name {
+			return child
+		}
+	}
+	child := &folderNode{name: name}
+	n.children = append(n.children, child)
+	return child
+}
+
+func (n *folderNode) outlines() []Outline {
+	sort.SliceStable(n.children, func(i, j int) bool { return n.children[i].name < n.children[j].name })
+
+	result := make([]Outline, 0, len(n.children)+len(n.feeds))
+	for _, child := range n.children {
+		result = append(result, Outline{Text: child.name, Title: child.name, Outlines: child.outlines()})
+	}
+	return append(result, n.feeds...)
+}
+
+func splitFolder(folder string) []string {
+	var parts []string
+	for _, part := range strings.Split(folder, FolderSeparator) {
+		if trimmed := strings.TrimSpace(part); trimmed != "" {
+			parts = append(parts, trimmed)
+		}
+	}
+	return parts
+}
@@ -0,0 +1,90 @@
+package opml_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"rssreader/internal/infra/opml"
+)
+
+const sampleOPML = `<?xml version="1.0" encoding="ISO-8859-1"?>
+<opml version="2.0">
+  <head>
+    <title>Minhas assinaturas</title>
+    <dateCreated>Mon, 02 Jan 2006 15:04:05 -0700</dateCreated>
+  </head>
+  <body>
+    <outline text="Notícias">
+      <outline text="G1" type="rss" xmlUrl="https://g1.globo.com/rss/g1/" htmlUrl="https://g1.globo.com"/>
+      <outline text="Tecnologia">
+        <outline text="Go Blog" title="The Go Blog" type="rss" xmlurl="https://go.dev/blog/feed.atom"/>
+      </outline>
+    </outline>
+    <outline text="Sem pasta" type="rss" xmlUrl="https://example.com/rss"/>
+    <outline text="Pasta vazia"/>
+  </body>
+</opml>`
+
+func TestParseFlattensNestedOutlinesIntoFolders(t *testing.T) {
+	latin1 := strings.ReplaceAll(sampleOPML, "Notícias", "Not\xedcias")
+
+	doc, err := opml.Parse(strings.NewReader(latin1))
+	if err != nil {
+		t.Fatalf("Parse() unexpected error: %v", err)
+	}
+
+	if doc.Title != "Minhas assinaturas" {
+		t.Errorf("unexpected title: %q", doc.Title)
+	}
+	if doc.DateCreated.IsZero() {
+		t.Error("expected dateCreated to be parsed")
+	}
+
+	expected := []opml.Entry{
+		{Title: "G1", XMLURL: "https://g1.globo.com/rss/g1/", HTMLURL: "https://g1.globo.com", Folder: "Notícias"},
+		{Title: "The Go Blog", XMLURL: "https://go.dev/blog/feed.atom", Folder: "Notícias/Tecnologia"},
+		{Title: "Sem pasta", XMLURL: "https://example.com/rss"},
+	}
+	if got := doc.Entries(); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("unexpected entries:\n got: %+v\nwant: %+v", got, expected)
+	}
+}
+
+func TestWriteAndParseRoundTrip(t *testing.T) {
+	entries := []opml.Entry{
+		{Title: "Sem pasta", XMLURL: "https://example.com/rss"},
+		{Title: "Go Blog", XMLURL: "https://go.dev/blog/feed.atom", Folder: "Tecnologia/Go"},
+		{Title: "G1 & Cia", XMLURL: "https://g1.globo.com/rss/g1/", HTMLURL: "https://g1.globo.com", Folder: "Notícias"},
+		{Title: "Hacker News", XMLURL: "https://hnrss.org/frontpage", Folder: "Tecnologia"},
+	}
+	created := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	if err := opml.Write(&buf, opml.FromEntries("Export", created, entries)); err != nil {
+		t.Fatalf("Write() unexpected error: %v", err)
+	}
+
+	doc, err := opml.Parse(&buf)
+	if err != nil {
+		t.Fatalf("Parse() unexpected error: %v\n%s", err, buf.String())
+	}
+
+	if doc.Title != "Export" || !doc.DateCreated.Equal(created) {
+		t.Errorf("unexpected head: %q %v", doc.Title, doc.DateCreated)
+	}
+
+	// Folders are written sorted by name, with unfiled feeds last.
+	expected := []opml.Entry{entries[2], entries[1], entries[3], entries[0]}
+	if got := doc.Entries(); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("round trip mismatch:\n got: %+v\nwant: %+v", got, expected)
+	}
+}
+
+func TestParseRejectsInvalidDocument(t *testing.T) {
+	if _, err := opml.Parse(strings.NewReader("<rss></rss>")); err == nil {
+		t.Fatal("expected error for non-OPML document")
+	}
+}
@@ -0,0 +1,136 @@
+package syndication
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+const atomNamespace = "http://www.w3.org/2005/Atom"
+
+type atomFeed struct {
+	XMLName    xml.Name       `xml:"feed"`
+	Namespace  string         `xml:"xmlns,attr"`
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Subtitle   string         `xml:"subtitle,omitempty"`
+	Updated    string         `xml:"updated"`
+	Links      []atomLink     `xml:"link"`
+	Logo       string         `xml:"logo,omitempty"`
+	Authors    []atomPerson   `xml:"author"`
+	Categories []atomCategory `xml:"category"`
+	Entries    []atomEntry    `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      atomText       `xml:"title"`
+	Updated    string         `xml:"updated"`
+	Published  string         `xml:"published,omitempty"`
+	Links      []atomLink     `xml:"link"`
+	Authors    []atomPerson   `xml:"author"`
+	Categories []atomCategory `xml:"category"`
+	Summary    *atomText      `xml:"summary"`
+	Content    *atomText      `xml:"content"`
+}
+
+type atomLink struct {
+	Href   string `xml:"href,attr"`
+	Rel    string `xml:"rel,attr,omitempty"`
+	Type   string `xml:"type,attr,omitempty"`
+	Length string `xml:"length,attr,omitempty"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomText struct {
+	Type string `xml:"type,attr,omitempty"`
+	Body string `xml:",chardata"`
+}
+
+// WriteAtom renders f as an Atom 1.0 document.
+func WriteAtom(w io.Writer, f *feed.Feed, opts Options) error {
+	id := f.SourceURL
+	if id == "" {
+		id = opts.SelfURL
+	}
+
+	doc := atomFeed{
+		Namespace:  atomNamespace,
+		ID:         id,
+		Title:      f.Title,
+		Subtitle:   f.Description,
+		Updated:    updated(f, opts).Format(time.RFC3339),
+		Logo:       f.Image,
+		Authors:    atomPeople(f.Authors),
+		Categories: atomCategories(f.Categories),
+	}
+	if opts.SelfURL != "" {
+		doc.Links = append(doc.Links, atomLink{Href: opts.SelfURL, Rel: "self", Type: "application/atom+xml"})
+	}
+	if f.Link != "" {
+		doc.Links = append(doc.Links, atomLink{Href: f.Link, Rel: "alternate", Type: "text/html"})
+	}
+
+	for _, item := range f.Items {
+		entry := atomEntry{
+			ID:         itemID(item),
+			Title:      atomText{Body: item.Title},
+			Updated:    itemUpdated(item).Format(time.RFC3339),
+			Authors:    atomPeople(item.Authors),
+			Categories: atomCategories(item.Categories),
+		}
+		if !item.PublishedAt.IsZero() {
+			entry.Published = item.PublishedAt.UTC().Format(time.RFC3339)
+		}
+		if item.Link != "" {
+			entry.Links = append(entry.Links, atomLink{Href: item.Link, Rel: "alternate", Type: "text/html"})
+		}
+		for _, enclosure := range item.Enclosures {
+			link := atomLink{Href: enclosure.URL, Rel: "enclosure", Type: enclosure.Type}
+			if enclosure.Length > 0 {
+				link.Length = strconv.FormatInt(enclosure.Length, 10)
+			}
+			entry.Links = append(entry.Links, link)
+		}
+		if item.Content != "" {
+			entry.Content = &atomText{Type: "html", Body: item.Content}
+		}
+		if item.Description != "" {
+			entry.Summary = &atomText{Type: "html", Body: item.Description}
+		}
+		doc.Entries = append(doc.Entries, entry)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	return encoder.Encode(doc)
+}
+
+func atomPeople(names []string) []atomPerson {
+	people := make([]atomPerson, 0, len(names))
+	for _, name := range names {
+		people = append(people, atomPerson{Name: name})
+	}
+	return people
+}
+
+func atomCategories(terms []string) []atomCategory {
+	categories := make([]atomCategory, 0, len(terms))
+	for _, term := range terms {
+		categories = append(categories, atomCategory{Term: term})
+	}
+	return categories
+}
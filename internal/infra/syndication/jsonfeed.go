@@ -0,0 +1,113 @@
+package syndication
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+// JSONFeedVersion identifies the JSON Feed specification the output follows.
+const JSONFeedVersion = "https://jsonfeed.org/version/1.1"
+
+type jsonFeed struct {
+	Version     string           `json:"version"`
+	Title       string           `json:"title"`
+	HomePageURL string           `json:"home_page_url,omitempty"`
+	FeedURL     string           `json:"feed_url,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Icon        string           `json:"icon,omitempty"`
+	Authors     []jsonFeedAuthor `json:"authors,omitempty"`
+	Items       []jsonFeedItem   `json:"items"`
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+}
+
+type jsonFeedItem struct {
+	ID            string               `json:"id"`
+	URL           string               `json:"url,omitempty"`
+	Title         string               `json:"title,omitempty"`
+	ContentHTML   string               `json:"content_html,omitempty"`
+	Summary       string               `json:"summary,omitempty"`
+	Image         string               `json:"image,omitempty"`
+	DatePublished *time.Time           `json:"date_published,omitempty"`
+	DateModified  *time.Time           `json:"date_modified,omitempty"`
+	Authors       []jsonFeedAuthor     `json:"authors,omitempty"`
+	Tags          []string             `json:"tags,omitempty"`
+	Attachments   []jsonFeedAttachment `json:"attachments,omitempty"`
+}
+
+type jsonFeedAttachment struct {
+	URL         string `json:"url"`
+	MimeType    string `json:"mime_type"`
+	SizeInBytes int64  `json:"size_in_bytes,omitempty"`
+}
+
+// WriteJSONFeed renders f as a JSON Feed 1.1 document.
+func WriteJSONFeed(w io.Writer, f *feed.Feed, opts Options) error {
+	doc := jsonFeed{
+		Version:     JSONFeedVersion,
+		Title:       f.Title,
+		HomePageURL: f.Link,
+		FeedURL:     opts.SelfURL,
+		Description: f.Description,
+		Icon:        f.Image,
+		Authors:     jsonFeedAuthors(f.Authors),
+		Items:       make([]jsonFeedItem, 0, len(f.Items)),
+	}
+
+	for _, item := range f.Items {
+		entry := jsonFeedItem{
+			ID:            itemID(item),
+			URL:           item.Link,
+			Title:         item.Title,
+			ContentHTML:   itemHTML(item),
+			Image:         item.Image,
+			DatePublished: optionalTime(item.PublishedAt),
+			DateModified:  optionalTime(item.UpdatedAt),
+			Authors:       jsonFeedAuthors(item.Authors),
+			Tags:          item.Categories,
+		}
+		if item.Content != "" {
+			entry.Summary = item.Description
+		}
+		for _, enclosure := range item.Enclosures {
+			mimeType := enclosure.Type
+			if mimeType == "" {
+				mimeType = "application/octet-stream"
+			}
+			entry.Attachments = append(entry.Attachments, jsonFeedAttachment{
+				URL:         enclosure.URL,
+				MimeType:    mimeType,
+				SizeInBytes: enclosure.Length,
+			})
+		}
+		doc.Items = append(doc.Items, entry)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(doc)
+}
+
+func jsonFeedAuthors(names []string) []jsonFeedAuthor {
+	authors := make([]jsonFeedAuthor, 0, len(names))
+	for _, name := range names {
+		authors = append(authors, jsonFeedAuthor{Name: name})
+	}
+	if len(authors) == 0 {
+		return nil
+	}
+	return authors
+}
+
+func optionalTime(value time.Time) *time.Time {
+	if value.IsZero() {
+		return nil
+	}
+	utc := value.UTC()
+	return &utc
+}
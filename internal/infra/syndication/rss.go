@@ -0,0 +1,142 @@
+package syndication
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+type rssDocument struct {
+	XMLName          xml.Name   `xml:"rss"`
+	Version          string     `xml:"version,attr"`
+	AtomNamespace    string     `xml:"xmlns:atom,attr"`
+	ContentNamespace string     `xml:"xmlns:content,attr"`
+	DCNamespace      string     `xml:"xmlns:dc,attr"`
+	Channel          rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	SelfLink      *atomLink `xml:"atom:link"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Generator     string    `xml:"generator"`
+	Categories    []string  `xml:"category"`
+	Image         *rssImage `xml:"image"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssImage struct {
+	URL   string `xml:"url"`
+	Title string `xml:"title"`
+	Link  string `xml:"link"`
+}
+
+type rssItem struct {
+	Title       string        `xml:"title,omitempty"`
+	Link        string        `xml:"link,omitempty"`
+	Description string        `xml:"description,omitempty"`
+	Content     *rssCDATA     `xml:"content:encoded"`
+	Creators    []string      `xml:"dc:creator"`
+	Categories  []string      `xml:"category"`
+	Enclosure   *rssEnclosure `xml:"enclosure"`
+	GUID        rssGUID       `xml:"guid"`
+	PubDate     string        `xml:"pubDate,omitempty"`
+	Source      *rssSource    `xml:"source"`
+}
+
+type rssCDATA struct {
+	Body string `xml:",cdata"`
+}
+
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssSource struct {
+	URL   string `xml:"url,attr"`
+	Title string `xml:",chardata"`
+}
+
+// WriteRSS renders f as an RSS 2.0 document. Items of a merged feed name their
+// originating feed in <source>.
+func WriteRSS(w io.Writer, f *feed.Feed, opts Options) error {
+	channel := rssChannel{
+		Title:         f.Title,
+		Link:          f.Link,
+		Description:   f.Description,
+		LastBuildDate: updated(f, opts).Format(time.RFC1123Z),
+		Generator:     "RSS Reader",
+		Categories:    f.Categories,
+	}
+	if channel.Link == "" {
+		channel.Link = opts.SelfURL
+	}
+	if channel.Description == "" {
+		channel.Description = f.Title
+	}
+	if opts.SelfURL != "" {
+		channel.SelfLink = &atomLink{Href: opts.SelfURL, Rel: "self", Type: "application/rss+xml"}
+	}
+	if f.Image != "" {
+		channel.Image = &rssImage{URL: f.Image, Title: f.Title, Link: channel.Link}
+	}
+
+	for _, item := range f.Items {
+		entry := rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			Description: item.Description,
+			Creators:    item.Authors,
+			Categories:  item.Categories,
+		}
+		switch {
+		case item.GUID != "":
+			entry.GUID = rssGUID{Value: item.GUID}
+		case item.Link != "":
+			entry.GUID = rssGUID{Value: item.Link, IsPermaLink: true}
+		default:
+			entry.GUID = rssGUID{Value: itemID(item)}
+		}
+		if item.Content != "" {
+			entry.Content = &rssCDATA{Body: item.Content}
+		}
+		if !item.PublishedAt.IsZero() {
+			entry.PubDate = item.PublishedAt.Format(time.RFC1123Z)
+		}
+		if len(item.Enclosures) > 0 {
+			// RSS 2.0 allows a single enclosure per item.
+			enclosure := item.Enclosures[0]
+			entry.Enclosure = &rssEnclosure{URL: enclosure.URL, Length: enclosure.Length, Type: enclosure.Type}
+		}
+		if f.SourceURL == "" && item.FeedURL != "" {
+			entry.Source = &rssSource{URL: item.FeedURL, Title: item.FeedURL}
+		}
+		channel.Items = append(channel.Items, entry)
+	}
+
+	doc := rssDocument{
+		Version:          "2.0",
+		AtomNamespace:    atomNamespace,
+		ContentNamespace: "http://purl.org/rss/1.0/modules/content/",
+		DCNamespace:      "http://purl.org/dc/elements/1.1/",
+		Channel:          channel,
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	return encoder.Encode(doc)
+}
@@ -0,0 +1,135 @@
+// Package syndication renders stored feeds as JSON Feed 1.1, Atom 1.0 or RSS 2.0.
+package syndication
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/url"
+	"strings"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+// Content types written for each format.
+const (
+	ContentTypeJSONFeed = "application/feed+json; charset=utf-8"
+	ContentTypeAtom     = "application/atom+xml; charset=utf-8"
+	ContentTypeRSS      = "application/rss+xml; charset=utf-8"
+)
+
+// Options carry request-specific details that are not part of the stored feed.
+type Options struct {
+	// SelfURL is the address the rendered document is served from.
+	SelfURL string
+	// Generated is used when the feed carries no update or fetch time.
+	Generated time.Time
+}
+
+// ContentType returns the media type for format, or "" when the format is unknown.
+func ContentType(format string) string {
+	switch format {
+	case feed.FormatJSON:
+		return ContentTypeJSONFeed
+	case feed.FormatAtom:
+		return ContentTypeAtom
+	case feed.FormatRSS:
+		return ContentTypeRSS
+	default:
+		return ""
+	}
+}
+
+// Negotiate picks a format from an Accept header, honouring quality values. It
+// returns "" when none of the acceptable types maps to a supported format.
+func Negotiate(accept string) string {
+	var (
+		best    string
+		bestQ   = 0.0
+		formats = map[string]string{
+			"application/feed+json": feed.FormatJSON,
+			"application/json":      feed.FormatJSON,
+			"application/atom+xml":  feed.FormatAtom,
+			"application/rss+xml":   feed.FormatRSS,
+			"application/xml":       feed.FormatRSS,
+			"text/xml":              feed.FormatRSS,
+		}
+	)
+
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		format, ok := formats[mediaType]
+		if !ok {
+			continue
+		}
+		q := 1.0
+		if raw, ok := params["q"]; ok {
+			if _, err := fmt.Sscanf(raw, "%g", &q); err != nil {
+				continue
+			}
+		}
+		if q > bestQ {
+			best, bestQ = format, q
+		}
+	}
+
+	return best
+}
+
+// Write renders f in the given format.
+func Write(w io.Writer, format string, f *feed.Feed, opts Options) error {
+	switch format {
+	case feed.FormatJSON:
+		return WriteJSONFeed(w, f, opts)
+	case feed.FormatAtom:
+		return WriteAtom(w, f, opts)
+	case feed.FormatRSS:
+		return WriteRSS(w, f, opts)
+	default:
+		return fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+// updated returns the most meaningful modification time of the feed.
+func updated(f *feed.Feed, opts Options) time.Time {
+	for _, candidate := range []time.Time{f.UpdatedAt, f.FetchedAt, opts.Generated} {
+		if !candidate.IsZero() {
+			return candidate.UTC()
+		}
+	}
+	return time.Now().UTC()
+}
+
+// itemUpdated falls back to the publication date when the item was never updated.
+func itemUpdated(item feed.Item) time.Time {
+	if !item.UpdatedAt.IsZero() {
+		return item.UpdatedAt.UTC()
+	}
+	return item.PublishedAt.UTC()
+}
+
+// itemID returns a globally unique identifier for the item: its GUID or link when
+// they are absolute URIs, otherwise a URN derived from the feed URL and item key.
+func itemID(item feed.Item) string {
+	for _, candidate := range []string{item.GUID, item.Link} {
+		if parsed, err := url.Parse(candidate); err == nil && parsed.IsAbs() {
+			return candidate
+		}
+	}
+	sum := sha256.Sum256([]byte(item.FeedURL + "\n" + item.Key()))
+	return "urn:sha256:" + hex.EncodeToString(sum[:])
+}
+
+// itemHTML returns the richest HTML body of the item.
+func itemHTML(item feed.Item) string {
+	if item.Content != "" {
+		return item.Content
+	}
+	return item.Description
+}
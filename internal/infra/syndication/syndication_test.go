@@ -0,0 +1,101 @@
+package syndication_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/infra/syndication"
+)
+
+func sampleFeed() *feed.Feed {
+	published := time.Date(2024, time.May, 10, 12, 0, 0, 0, time.UTC)
+	return &feed.Feed{
+		SourceURL:   "https://example.com/rss.xml",
+		Title:       "Example",
+		Description: "Example feed",
+		Link:        "https://example.com",
+		Image:       "https://example.com/logo.png",
+		FetchedAt:   published.Add(time.Hour),
+		Items: []feed.Item{
+			{
+				GUID:        "episode-1",
+				Title:       "Episode 1",
+				Link:        "https://example.com/ep1",
+				Description: "Summary",
+				Content:     "<p>Show notes ]]> with a CDATA terminator</p>",
+				Authors:     []string{"Maria Silva"},
+				Categories:  []string{"Go"},
+				Enclosures:  []feed.Enclosure{{URL: "https://example.com/ep1.mp3", Type: "audio/mpeg", Length: 123}},
+				PublishedAt: published,
+			},
+			{Title: "No identifiers", Description: "Plain", PublishedAt: published.Add(-time.Hour)},
+		},
+	}
+}
+
+func TestWriteRoundTripsThroughParser(t *testing.T) {
+	for _, format := range []string{feed.FormatJSON, feed.FormatAtom, feed.FormatRSS} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := syndication.Write(&buf, format, sampleFeed(), syndication.Options{SelfURL: "https://reader.example/api/output?format=" + format})
+			if err != nil {
+				t.Fatalf("write: %v", err)
+			}
+
+			parsed, err := gofeed.NewParser().Parse(bytes.NewReader(buf.Bytes()))
+			if err != nil {
+				t.Fatalf("parse %s output: %v\n%s", format, err, buf.String())
+			}
+			if parsed.FeedType != format {
+				t.Errorf("expected feed type %s, got %s", format, parsed.FeedType)
+			}
+			if parsed.Title != "Example" || len(parsed.Items) != 2 {
+				t.Fatalf("unexpected feed: %q with %d items", parsed.Title, len(parsed.Items))
+			}
+
+			item := parsed.Items[0]
+			if item.Title != "Episode 1" || item.Link != "https://example.com/ep1" {
+				t.Errorf("unexpected item: %q %q", item.Title, item.Link)
+			}
+			if !strings.Contains(item.Content, "]]> with a CDATA terminator") {
+				t.Errorf("expected content to survive, got %q", item.Content)
+			}
+			if item.PublishedParsed == nil || !item.PublishedParsed.Equal(time.Date(2024, time.May, 10, 12, 0, 0, 0, time.UTC)) {
+				t.Errorf("unexpected published date: %v", item.PublishedParsed)
+			}
+			if len(item.Enclosures) != 1 || item.Enclosures[0].URL != "https://example.com/ep1.mp3" {
+				t.Errorf("unexpected enclosures: %+v", item.Enclosures)
+			}
+			if parsed.Items[1].GUID == "" {
+				t.Error("expected a generated identifier for items without guid or link")
+			}
+		})
+	}
+}
+
+func TestWriteRejectsUnknownFormat(t *testing.T) {
+	if err := syndication.Write(&bytes.Buffer{}, "opml", sampleFeed(), syndication.Options{}); err == nil {
+		t.Fatal("expected error for unknown format")
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	cases := map[string]string{
+		"":                                       "",
+		"text/html":                              "",
+		"application/feed+json":                  feed.FormatJSON,
+		"application/atom+xml, application/json": feed.FormatAtom,
+		"application/rss+xml;q=0.5, application/atom+xml;q=0.9": feed.FormatAtom,
+		"text/html, application/xml;q=0.8":                      feed.FormatRSS,
+	}
+	for accept, want := range cases {
+		if got := syndication.Negotiate(accept); got != want {
+			t.Errorf("Negotiate(%q) = %q, want %q", accept, got, want)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package http
+
+import (
+	"net/http"
+	"time"
+)
+
+func (h *Handler) getHostStatuses(w http.ResponseWriter, r *http.Request) {
+	if h.listHosts == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	statuses, err := h.listHosts.Execute(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := make([]hostStatusResponse, 0, len(statuses))
+	for _, status := range statuses {
+		response = append(response, hostStatusResponse{
+			Host:                status.Host,
+			State:               status.State,
+			ConsecutiveFailures: status.ConsecutiveFailures,
+			LastError:           status.LastError,
+			OpenedAt:            optionalTime(status.OpenedAt),
+			RetryAt:             optionalTime(status.RetryAt),
+		})
+	}
+
+	writeJSON(w, hostsResponse{Hosts: response})
+}
+
+type hostsResponse struct {
+	Hosts []hostStatusResponse `json:"hosts"`
+}
+
+type hostStatusResponse struct {
+	Host                string     `json:"host"`
+	State               string     `json:"state"`
+	ConsecutiveFailures int        `json:"consecutiveFailures"`
+	LastError           string     `json:"lastError,omitempty"`
+	OpenedAt            *time.Time `json:"openedAt,omitempty"`
+	RetryAt             *time.Time `json:"retryAt,omitempty"`
+}
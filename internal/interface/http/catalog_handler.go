@@ -0,0 +1,193 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+func (h *Handler) getCatalog(w http.ResponseWriter, r *http.Request) {
+	h.writeCatalog(w, r, false)
+}
+
+func (h *Handler) getCatalogAdmin(w http.ResponseWriter, r *http.Request) {
+	h.writeCatalog(w, r, true)
+}
+
+func (h *Handler) writeCatalog(w http.ResponseWriter, r *http.Request, includeDisabled bool) {
+	if h.catalog == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	categories, err := h.catalog.Execute(r.Context(), includeDisabled)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := catalogResponse{Categories: make([]catalogCategoryResponse, 0, len(categories))}
+	for _, category := range categories {
+		response.Categories = append(response.Categories, toCatalogCategoryResponse(category))
+	}
+
+	writeJSON(w, response)
+}
+
+func (h *Handler) createCatalogCategory(w http.ResponseWriter, r *http.Request) {
+	if h.addCategory == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	var req catalogCategoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, invalidInput("invalid request body"))
+		return
+	}
+
+	category, err := h.addCategory.Execute(r.Context(), feed.CatalogCategory{
+		ID:          req.ID,
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(toCatalogCategoryResponse(*category))
+}
+
+func (h *Handler) createCatalogSource(w http.ResponseWriter, r *http.Request) {
+	if h.addSource == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	var req catalogSourceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, invalidInput("invalid request body"))
+		return
+	}
+
+	source, err := h.addSource.Execute(r.Context(), feed.CatalogSource{
+		CategoryID:  req.CategoryID,
+		Name:        req.Name,
+		URL:         req.URL,
+		Description: req.Description,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(toCatalogSourceResponse(*source))
+}
+
+func (h *Handler) updateCatalogSource(w http.ResponseWriter, r *http.Request) {
+	if h.toggleSource == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, invalidInput("invalid catalog source id"))
+		return
+	}
+
+	var req catalogSourceUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, invalidInput("invalid request body"))
+		return
+	}
+	if req.Disabled == nil {
+		writeError(w, invalidInput("disabled is required"))
+		return
+	}
+
+	source, err := h.toggleSource.Execute(r.Context(), id, *req.Disabled)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, toCatalogSourceResponse(*source))
+}
+
+type catalogCategoryRequest struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type catalogSourceRequest struct {
+	CategoryID  string `json:"categoryId"`
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	Description string `json:"description"`
+}
+
+type catalogSourceUpdateRequest struct {
+	Disabled *bool `json:"disabled"`
+}
+
+type catalogResponse struct {
+	Categories []catalogCategoryResponse `json:"categories"`
+}
+
+type catalogCategoryResponse struct {
+	ID          string                  `json:"id"`
+	Name        string                  `json:"name"`
+	Description string                  `json:"description"`
+	Sources     []catalogSourceResponse `json:"sources"`
+}
+
+type catalogSourceResponse struct {
+	ID          int64  `json:"id"`
+	CategoryID  string `json:"categoryId"`
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+	Disabled    bool   `json:"disabled,omitempty"`
+	// Broken flags sources that failed their recent health checks.
+	Broken    bool       `json:"broken"`
+	LastError string     `json:"lastError,omitempty"`
+	CheckedAt *time.Time `json:"checkedAt,omitempty"`
+}
+
+func toCatalogCategoryResponse(category feed.CatalogCategory) catalogCategoryResponse {
+	sources := make([]catalogSourceResponse, 0, len(category.Sources))
+	for _, source := range category.Sources {
+		sources = append(sources, toCatalogSourceResponse(source))
+	}
+
+	return catalogCategoryResponse{
+		ID:          category.ID,
+		Name:        category.Name,
+		Description: category.Description,
+		Sources:     sources,
+	}
+}
+
+func toCatalogSourceResponse(source feed.CatalogSource) catalogSourceResponse {
+	return catalogSourceResponse{
+		ID:          source.ID,
+		CategoryID:  source.CategoryID,
+		Name:        source.Name,
+		URL:         source.URL,
+		Description: source.Description,
+		Disabled:    source.Disabled,
+		Broken:      source.Broken(),
+		LastError:   source.CheckError,
+		CheckedAt:   optionalTime(source.CheckedAt),
+	}
+}
@@ -0,0 +1,40 @@
+package http
+
+import (
+	"net/http"
+	"time"
+)
+
+func (h *Handler) discoverFeeds(w http.ResponseWriter, r *http.Request) {
+	if h.discover == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	// Probing common paths may take several round trips.
+	ctx, cancel := withWriteBudget(w, r, 20*time.Second)
+	defer cancel()
+
+	candidates, err := h.discover.Execute(ctx, r.URL.Query().Get("url"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := make([]candidateResponse, 0, len(candidates))
+	for _, candidate := range candidates {
+		response = append(response, candidateResponse(candidate))
+	}
+
+	writeJSON(w, discoverResponse{Candidates: response})
+}
+
+type discoverResponse struct {
+	Candidates []candidateResponse `json:"candidates"`
+}
+
+type candidateResponse struct {
+	URL    string `json:"url"`
+	Title  string `json:"title,omitempty"`
+	Format string `json:"format"`
+}
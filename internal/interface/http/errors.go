@@ -0,0 +1,82 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"rssreader/internal/domain/feed"
+)
+
+// Machine-readable error codes returned in the "code" field of error responses.
+const (
+	codeInvalidInput        = "invalid_input"
+	codeNotFound            = "not_found"
+	codeConflict            = "conflict"
+	codeUnparseableFeed     = "unparseable_feed"
+	codeUpstreamStatus      = "upstream_status"
+	codeUpstreamUnreachable = "upstream_unreachable"
+	codeUpstreamTooLarge    = "upstream_too_large"
+	codeUpstreamTimeout     = "upstream_timeout"
+	codeUpstreamUnavailable = "upstream_unavailable"
+	codeStorageUnavailable  = "storage_unavailable"
+	codeNotSupported        = "not_supported"
+	codeInternal            = "internal"
+)
+
+type errorResponse struct {
+	Error string `json:"error"`
+	Code  string `json:"code"`
+	// UpstreamStatus is the publisher's status code for upstream_status errors.
+	UpstreamStatus int `json:"upstreamStatus,omitempty"`
+}
+
+// errorMappings pair error categories with their HTTP status and code. Order matters:
+// the first category matched by errors.Is wins.
+var errorMappings = []struct {
+	target error
+	status int
+	code   string
+}{
+	{feed.ErrInvalidInput, http.StatusBadRequest, codeInvalidInput},
+	{feed.ErrNotFound, http.StatusNotFound, codeNotFound},
+	{feed.ErrConflict, http.StatusConflict, codeConflict},
+	{feed.ErrUpstreamTimeout, http.StatusGatewayTimeout, codeUpstreamTimeout},
+	{feed.ErrUpstreamUnavailable, http.StatusServiceUnavailable, codeUpstreamUnavailable},
+	{feed.ErrUpstreamStatus, http.StatusBadGateway, codeUpstreamStatus},
+	{feed.ErrFeedTooLarge, http.StatusBadGateway, codeUpstreamTooLarge},
+	{feed.ErrUpstreamUnreachable, http.StatusBadGateway, codeUpstreamUnreachable},
+	{feed.ErrUnparseableFeed, http.StatusUnprocessableEntity, codeUnparseableFeed},
+	{feed.ErrStorage, http.StatusServiceUnavailable, codeStorageUnavailable},
+	{http.ErrNotSupported, http.StatusNotImplemented, codeNotSupported},
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	status, body := toErrorResponse(err)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// toErrorResponse classifies err into its HTTP status and response body.
+func toErrorResponse(err error) (int, errorResponse) {
+	status, body := http.StatusInternalServerError, errorResponse{Error: err.Error(), Code: codeInternal}
+	for _, mapping := range errorMappings {
+		if errors.Is(err, mapping.target) {
+			status, body.Code = mapping.status, mapping.code
+			break
+		}
+	}
+
+	var upstream *feed.UpstreamStatusError
+	if errors.As(err, &upstream) {
+		body.UpstreamStatus = upstream.StatusCode
+	}
+	return status, body
+}
+
+// invalidInput marks a request that could not be decoded as a client error.
+func invalidInput(message string) error {
+	return fmt.Errorf("%w: %s", feed.ErrInvalidInput, message)
+}
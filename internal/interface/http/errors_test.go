@@ -0,0 +1,57 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+)
+
+func TestWriteErrorMapsCategories(t *testing.T) {
+	cases := []struct {
+		err    error
+		status int
+		code   string
+	}{
+		{fmt.Errorf("%w: url is required", feed.ErrInvalidInput), http.StatusBadRequest, codeInvalidInput},
+		{fmt.Errorf("item %w", feed.ErrNotFound), http.StatusNotFound, codeNotFound},
+		{fmt.Errorf("folder %q %w", "News", feed.ErrConflict), http.StatusConflict, codeConflict},
+		{fmt.Errorf("parse feed: %w: bad xml", feed.ErrUnparseableFeed), http.StatusUnprocessableEntity, codeUnparseableFeed},
+		{fmt.Errorf("fetch feed: %w", &feed.UpstreamStatusError{StatusCode: 404}), http.StatusBadGateway, codeUpstreamStatus},
+		{fmt.Errorf("fetch feed: %w: refused", feed.ErrUpstreamUnreachable), http.StatusBadGateway, codeUpstreamUnreachable},
+		{fmt.Errorf("fetch feed: %w: deadline", feed.ErrUpstreamTimeout), http.StatusGatewayTimeout, codeUpstreamTimeout},
+		{fmt.Errorf("list feeds: %w: conn refused", feed.ErrStorage), http.StatusServiceUnavailable, codeStorageUnavailable},
+		{http.ErrNotSupported, http.StatusNotImplemented, codeNotSupported},
+		{errors.New("feed store not configured"), http.StatusInternalServerError, codeInternal},
+	}
+
+	for _, tc := range cases {
+		rec := httptest.NewRecorder()
+		writeError(rec, tc.err)
+
+		var body errorResponse
+		if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if rec.Code != tc.status || body.Code != tc.code || body.Error != tc.err.Error() {
+			t.Errorf("%v: got %d %+v, want %d %s", tc.err, rec.Code, body, tc.status, tc.code)
+		}
+	}
+}
+
+func TestWriteErrorIncludesUpstreamStatus(t *testing.T) {
+	rec := httptest.NewRecorder()
+	writeError(rec, fmt.Errorf("fetch feed: %w", &feed.UpstreamStatusError{StatusCode: 410}))
+
+	var body errorResponse
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if body.UpstreamStatus != 410 {
+		t.Fatalf("expected upstream status 410, got %+v", body)
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
 	"time"
 
 	"rssreader/internal/domain/feed"
+	"rssreader/internal/usecase/listfeeds"
 )
 
 func (h *Handler) getFeed(w http.ResponseWriter, r *http.Request) {
@@ -16,24 +18,27 @@ func (h *Handler) getFeed(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	feed, err := h.fetch.Execute(ctx, url)
+	result, err := h.fetch.Execute(ctx, url)
 	if err != nil {
 		writeError(w, err)
 		return
 	}
 
-	writeJSON(w, toResponse(feed))
-}
-
-func (h *Handler) handleRecentFeeds(w http.ResponseWriter, r *http.Request) {
-	switch r.Method {
-	case http.MethodGet:
-		h.getRecentFeeds(w, r)
-	case http.MethodDelete:
-		h.clearRecentFeeds(w, r)
-	default:
-		w.WriteHeader(http.StatusMethodNotAllowed)
+	response := toResponse(result.Feed)
+	response.Age = int64(result.Age / time.Second)
+	if response.Age > 0 {
+		w.Header().Set("Age", strconv.FormatInt(response.Age, 10))
+	}
+	if result.Stale {
+		// RFC 7234 warn-codes for a stale response served after revalidation failed.
+		w.Header().Add("Warning", `110 - "Response is Stale"`)
+		w.Header().Add("Warning", `111 - "Revalidation Failed"`)
+		_, upstream := toErrorResponse(result.UpstreamErr)
+		response.Stale = true
+		response.UpstreamError = &upstream
 	}
+
+	writeJSON(w, response)
 }
 
 func (h *Handler) getRecentFeeds(w http.ResponseWriter, r *http.Request) {
@@ -42,25 +47,44 @@ func (h *Handler) getRecentFeeds(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ctx := r.Context()
-	feeds, err := h.list.Execute(ctx, 10)
+	params := r.URL.Query()
+	query := listfeeds.Query{
+		Sort:   params.Get("sort"),
+		Cursor: params.Get("cursor"),
+		Tag:    params.Get("tag"),
+	}
+	query.Limit, _ = strconv.Atoi(params.Get("limit"))
+	if raw := params.Get("folderId"); raw != "" {
+		var err error
+		if query.FolderID, err = strconv.ParseInt(raw, 10, 64); err != nil {
+			writeError(w, invalidInput("invalid folder id"))
+			return
+		}
+	}
+
+	page, err := h.list.Execute(r.Context(), query)
 	if err != nil {
 		writeError(w, err)
 		return
 	}
 
-	response := make([]feedSummaryResponse, 0, len(feeds))
-	for _, entry := range feeds {
+	response := make([]feedSummaryResponse, 0, len(page.Feeds))
+	for _, entry := range page.Feeds {
 		response = append(response, feedSummaryResponse{
+			ID:          entry.ID,
 			SourceURL:   entry.SourceURL,
 			Title:       entry.Title,
 			Description: entry.Description,
 			Link:        entry.Link,
 			FetchedAt:   entry.FetchedAt,
+			UnreadCount: entry.UnreadCount,
+			FolderID:    entry.FolderID,
+			Tags:        entry.Tags,
+			Health:      entry.Health,
 		})
 	}
 
-	writeJSON(w, recentFeedsResponse{Feeds: response})
+	writeJSON(w, recentFeedsResponse{Feeds: response, NextCursor: page.NextCursor})
 }
 
 func (h *Handler) clearRecentFeeds(w http.ResponseWriter, r *http.Request) {
@@ -77,47 +101,181 @@ func (h *Handler) clearRecentFeeds(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
-type errorResponse struct {
-	Error string `json:"error"`
-}
-
 type feedResponse struct {
 	SourceURL   string         `json:"sourceUrl"`
 	Title       string         `json:"title"`
 	Description string         `json:"description"`
 	Link        string         `json:"link"`
+	Image       string         `json:"image,omitempty"`
+	Authors     []string       `json:"authors,omitempty"`
+	Categories  []string       `json:"categories,omitempty"`
+	UpdatedAt   *time.Time     `json:"updatedAt,omitempty"`
 	Items       []feedItemResp `json:"items"`
 	FetchedAt   time.Time      `json:"fetchedAt"`
+	CheckedAt   time.Time      `json:"checkedAt"`
+	// Stale marks a stored snapshot served because the download failed with UpstreamError.
+	Stale         bool           `json:"stale,omitempty"`
+	UpstreamError *errorResponse `json:"upstreamError,omitempty"`
+	// Age is how many seconds ago the publisher last confirmed the snapshot.
+	Age int64 `json:"age,omitempty"`
 }
 
 type feedItemResp struct {
-	Title       string    `json:"title"`
-	Link        string    `json:"link"`
-	Description string    `json:"description"`
-	PublishedAt time.Time `json:"publishedAt"`
+	ID          int64           `json:"id,omitempty"`
+	FeedURL     string          `json:"feedUrl,omitempty"`
+	GUID        string          `json:"guid,omitempty"`
+	Title       string          `json:"title"`
+	Link        string          `json:"link"`
+	Description string          `json:"description"`
+	Content     string          `json:"content,omitempty"`
+	Authors     []string        `json:"authors,omitempty"`
+	Categories  []string        `json:"categories,omitempty"`
+	Image       string          `json:"image,omitempty"`
+	Enclosures  []enclosureResp `json:"enclosures,omitempty"`
+	PublishedAt time.Time       `json:"publishedAt"`
+	UpdatedAt   *time.Time      `json:"updatedAt,omitempty"`
+	Read        bool            `json:"read"`
+	Starred     bool            `json:"starred"`
+	Archived    bool            `json:"archived"`
+}
+
+type enclosureResp struct {
+	URL    string `json:"url"`
+	Type   string `json:"type,omitempty"`
+	Length int64  `json:"length,omitempty"`
+}
+
+func (h *Handler) deleteFeed(w http.ResponseWriter, r *http.Request) {
+	if h.remove == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	if err := h.remove.Execute(r.Context(), r.PathValue("ref")); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) refreshFeed(w http.ResponseWriter, r *http.Request) {
+	if h.refresh == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, invalidInput("invalid feed id"))
+		return
+	}
+
+	ctx, cancel := withWriteBudget(w, r, 20*time.Second)
+	defer cancel()
+
+	result, err := h.refresh.Execute(ctx, id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := refreshFeedResponse{
+		NewItems:     len(result.Added),
+		UpdatedItems: make([]feedItemResp, 0, len(result.Updated)),
+		Feed:         toResponse(result.Feed),
+	}
+	for _, item := range result.Updated {
+		response.UpdatedItems = append(response.UpdatedItems, toItemResponse(item))
+	}
+
+	writeJSON(w, response)
+}
+
+type refreshFeedResponse struct {
+	NewItems     int            `json:"newItems"`
+	UpdatedItems []feedItemResp `json:"updatedItems"`
+	Feed         feedResponse   `json:"feed"`
+}
+
+func (h *Handler) getFeedHistory(w http.ResponseWriter, r *http.Request) {
+	if h.history == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, invalidInput("invalid feed id"))
+		return
+	}
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	history, err := h.history.Execute(r.Context(), id, limit)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := feedHistoryResponse{
+		SourceURL: history.SourceURL,
+		Health:    history.Health,
+		Attempts:  make([]fetchAttemptResponse, 0, len(history.Attempts)),
+	}
+	for _, attempt := range history.Attempts {
+		response.Attempts = append(response.Attempts, fetchAttemptResponse{
+			AttemptedAt: attempt.AttemptedAt,
+			StatusCode:  attempt.StatusCode,
+			DurationMS:  attempt.Duration.Milliseconds(),
+			Bytes:       attempt.Bytes,
+			ErrorClass:  attempt.ErrorClass,
+			Error:       attempt.Error,
+			ItemDelta:   attempt.ItemDelta,
+		})
+	}
+
+	writeJSON(w, response)
+}
+
+type feedHistoryResponse struct {
+	SourceURL string                 `json:"sourceUrl"`
+	Health    string                 `json:"health,omitempty"`
+	Attempts  []fetchAttemptResponse `json:"attempts"`
+}
+
+type fetchAttemptResponse struct {
+	AttemptedAt time.Time `json:"attemptedAt"`
+	StatusCode  int       `json:"statusCode,omitempty"`
+	DurationMS  int64     `json:"durationMs"`
+	Bytes       int64     `json:"bytes"`
+	ErrorClass  string    `json:"errorClass,omitempty"`
+	Error       string    `json:"error,omitempty"`
+	ItemDelta   int       `json:"itemDelta"`
 }
 
 type recentFeedsResponse struct {
-	Feeds []feedSummaryResponse `json:"feeds"`
+	Feeds      []feedSummaryResponse `json:"feeds"`
+	NextCursor string                `json:"nextCursor,omitempty"`
 }
 
 type feedSummaryResponse struct {
+	ID          int64     `json:"id"`
 	SourceURL   string    `json:"sourceUrl"`
 	Title       string    `json:"title"`
 	Description string    `json:"description"`
 	Link        string    `json:"link"`
 	FetchedAt   time.Time `json:"fetchedAt"`
+	UnreadCount int       `json:"unreadCount"`
+	FolderID    int64     `json:"folderId,omitempty"`
+	Tags        []string  `json:"tags,omitempty"`
+	// Health is healthy, degraded or failing; it is omitted before the first fetch is logged.
+	Health string `json:"health,omitempty"`
 }
 
 func toResponse(f *feed.Feed) feedResponse {
 	items := make([]feedItemResp, 0, len(f.Items))
 	for _, item := range f.Items {
-		items = append(items, feedItemResp{
-			Title:       item.Title,
-			Link:        item.Link,
-			Description: item.Description,
-			PublishedAt: item.PublishedAt,
-		})
+		items = append(items, toItemResponse(item))
 	}
 
 	return feedResponse{
@@ -125,15 +283,46 @@ func toResponse(f *feed.Feed) feedResponse {
 		Title:       f.Title,
 		Description: f.Description,
 		Link:        f.Link,
+		Image:       f.Image,
+		Authors:     f.Authors,
+		Categories:  f.Categories,
+		UpdatedAt:   optionalTime(f.UpdatedAt),
 		Items:       items,
 		FetchedAt:   f.FetchedAt,
+		CheckedAt:   f.CheckedAt,
 	}
 }
 
-func writeError(w http.ResponseWriter, err error) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusBadRequest)
-	_ = json.NewEncoder(w).Encode(errorResponse{Error: err.Error()})
+func toItemResponse(item feed.Item) feedItemResp {
+	enclosures := make([]enclosureResp, 0, len(item.Enclosures))
+	for _, enclosure := range item.Enclosures {
+		enclosures = append(enclosures, enclosureResp(enclosure))
+	}
+
+	return feedItemResp{
+		ID:          item.ID,
+		GUID:        item.GUID,
+		Title:       item.Title,
+		Link:        item.Link,
+		Description: item.Description,
+		Content:     item.Content,
+		Authors:     item.Authors,
+		Categories:  item.Categories,
+		Image:       item.Image,
+		Enclosures:  enclosures,
+		PublishedAt: item.PublishedAt,
+		UpdatedAt:   optionalTime(item.UpdatedAt),
+		Read:        item.Read,
+		Starred:     item.Starred,
+		Archived:    item.Archived,
+	}
+}
+
+func optionalTime(value time.Time) *time.Time {
+	if value.IsZero() {
+		return nil
+	}
+	return &value
 }
 
 func writeJSON(w http.ResponseWriter, payload any) {
@@ -0,0 +1,225 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+func (h *Handler) getFolders(w http.ResponseWriter, r *http.Request) {
+	if h.listFolders == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	folders, err := h.listFolders.Execute(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := foldersResponse{Folders: make([]folderResponse, 0, len(folders))}
+	for _, folder := range folders {
+		response.Folders = append(response.Folders, toFolderResponse(folder))
+	}
+
+	writeJSON(w, response)
+}
+
+func (h *Handler) addFolder(w http.ResponseWriter, r *http.Request) {
+	if h.createFolder == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	var req folderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, invalidInput("invalid request body"))
+		return
+	}
+
+	folder, err := h.createFolder.Execute(r.Context(), req.Name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(toFolderResponse(*folder))
+}
+
+func (h *Handler) updateFolder(w http.ResponseWriter, r *http.Request) {
+	if h.renameFolder == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, invalidInput("invalid folder id"))
+		return
+	}
+
+	var req folderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, invalidInput("invalid request body"))
+		return
+	}
+
+	folder, err := h.renameFolder.Execute(r.Context(), id, req.Name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, toFolderResponse(*folder))
+}
+
+func (h *Handler) removeFolder(w http.ResponseWriter, r *http.Request) {
+	if h.deleteFolder == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, invalidInput("invalid folder id"))
+		return
+	}
+
+	if err := h.deleteFolder.Execute(r.Context(), id); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) fileFeed(w http.ResponseWriter, r *http.Request) {
+	if h.moveFeed == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, invalidInput("invalid feed id"))
+		return
+	}
+
+	var req moveFeedRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, invalidInput("invalid request body"))
+		return
+	}
+
+	var folderID int64
+	if req.FolderID != nil {
+		folderID = *req.FolderID
+	}
+	if err := h.moveFeed.Execute(r.Context(), id, folderID); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) setFeedTags(w http.ResponseWriter, r *http.Request) {
+	if h.tagFeed == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, invalidInput("invalid feed id"))
+		return
+	}
+
+	var req feedTagsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, invalidInput("invalid request body"))
+		return
+	}
+
+	tags, err := h.tagFeed.Execute(r.Context(), id, req.Tags)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, feedTagsResponse{Tags: tags})
+}
+
+func (h *Handler) getTags(w http.ResponseWriter, r *http.Request) {
+	if h.listTags == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	tags, err := h.listTags.Execute(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := tagsResponse{Tags: make([]tagResponse, 0, len(tags))}
+	for _, tag := range tags {
+		response.Tags = append(response.Tags, tagResponse{Tag: tag.Tag, FeedCount: tag.FeedCount})
+	}
+
+	writeJSON(w, response)
+}
+
+type folderRequest struct {
+	Name string `json:"name"`
+}
+
+// moveFeedRequest files a feed; a null or zero folder id unfiles it.
+type moveFeedRequest struct {
+	FolderID *int64 `json:"folderId"`
+}
+
+type feedTagsRequest struct {
+	Tags []string `json:"tags"`
+}
+
+type feedTagsResponse struct {
+	Tags []string `json:"tags"`
+}
+
+type foldersResponse struct {
+	Folders []folderResponse `json:"folders"`
+}
+
+type folderResponse struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	FeedCount   int       `json:"feedCount"`
+	UnreadCount int       `json:"unreadCount"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type tagsResponse struct {
+	Tags []tagResponse `json:"tags"`
+}
+
+type tagResponse struct {
+	Tag       string `json:"tag"`
+	FeedCount int    `json:"feedCount"`
+}
+
+func toFolderResponse(folder feed.Folder) folderResponse {
+	return folderResponse{
+		ID:          folder.ID,
+		Name:        folder.Name,
+		FeedCount:   folder.FeedCount,
+		UnreadCount: folder.UnreadCount,
+		CreatedAt:   folder.CreatedAt,
+	}
+}
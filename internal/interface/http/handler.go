@@ -3,25 +3,176 @@ package http
 import (
 	stdhttp "net/http"
 
+	"rssreader/internal/usecase/addcatalogcategory"
+	"rssreader/internal/usecase/addcatalogsource"
 	"rssreader/internal/usecase/clearfeeds"
+	"rssreader/internal/usecase/createfolder"
+	"rssreader/internal/usecase/deletefeed"
+	"rssreader/internal/usecase/deletefolder"
+	"rssreader/internal/usecase/discoverfeeds"
+	"rssreader/internal/usecase/exportfeed"
+	"rssreader/internal/usecase/exportsubscriptions"
+	"rssreader/internal/usecase/feedhistory"
 	"rssreader/internal/usecase/fetchfeed"
+	"rssreader/internal/usecase/getitem"
+	"rssreader/internal/usecase/importsubscriptions"
+	"rssreader/internal/usecase/listcatalog"
 	"rssreader/internal/usecase/listfeeds"
+	"rssreader/internal/usecase/listfolders"
+	"rssreader/internal/usecase/listhosts"
+	"rssreader/internal/usecase/listitems"
+	"rssreader/internal/usecase/listsubscriptions"
+	"rssreader/internal/usecase/listtags"
+	"rssreader/internal/usecase/listtimeline"
+	"rssreader/internal/usecase/markfeedread"
+	"rssreader/internal/usecase/markitem"
+	"rssreader/internal/usecase/movefeed"
+	"rssreader/internal/usecase/refreshfeed"
+	"rssreader/internal/usecase/renamefolder"
+	"rssreader/internal/usecase/searchitems"
+	"rssreader/internal/usecase/subscribe"
+	"rssreader/internal/usecase/tagfeed"
+	"rssreader/internal/usecase/togglecatalogsource"
+	"rssreader/internal/usecase/unsubscribe"
 )
 
+// UseCases groups the application use cases exposed over HTTP.
+// Nil entries make the matching routes respond with an error.
+type UseCases struct {
+	Fetch               *fetchfeed.UseCase
+	List                *listfeeds.UseCase
+	Clear               *clearfeeds.UseCase
+	Subscribe           *subscribe.UseCase
+	Unsubscribe         *unsubscribe.UseCase
+	ListSubscriptions   *listsubscriptions.UseCase
+	ListItems           *listitems.UseCase
+	GetItem             *getitem.UseCase
+	MarkItem            *markitem.UseCase
+	MarkFeedRead        *markfeedread.UseCase
+	ImportSubscriptions *importsubscriptions.UseCase
+	ExportSubscriptions *exportsubscriptions.UseCase
+	Search              *searchitems.UseCase
+	ListHosts           *listhosts.UseCase
+	Discover            *discoverfeeds.UseCase
+	ExportFeed          *exportfeed.UseCase
+	Timeline            *listtimeline.UseCase
+	DeleteFeed          *deletefeed.UseCase
+	RefreshFeed         *refreshfeed.UseCase
+	FeedHistory         *feedhistory.UseCase
+	ListFolders         *listfolders.UseCase
+	CreateFolder        *createfolder.UseCase
+	RenameFolder        *renamefolder.UseCase
+	DeleteFolder        *deletefolder.UseCase
+	MoveFeed            *movefeed.UseCase
+	TagFeed             *tagfeed.UseCase
+	ListTags            *listtags.UseCase
+	ListCatalog         *listcatalog.UseCase
+	AddCatalogCategory  *addcatalogcategory.UseCase
+	AddCatalogSource    *addcatalogsource.UseCase
+	ToggleCatalogSource *togglecatalogsource.UseCase
+}
+
 // Handler bundles HTTP handlers for the API surface.
 type Handler struct {
-	fetch *fetchfeed.UseCase
-	list  *listfeeds.UseCase
-	clear *clearfeeds.UseCase
+	fetch               *fetchfeed.UseCase
+	list                *listfeeds.UseCase
+	clear               *clearfeeds.UseCase
+	subscribe           *subscribe.UseCase
+	unsubscribe         *unsubscribe.UseCase
+	listSubscriptions   *listsubscriptions.UseCase
+	listItems           *listitems.UseCase
+	getItem             *getitem.UseCase
+	markItem            *markitem.UseCase
+	markFeedRead        *markfeedread.UseCase
+	importSubscriptions *importsubscriptions.UseCase
+	exportSubscriptions *exportsubscriptions.UseCase
+	search              *searchitems.UseCase
+	listHosts           *listhosts.UseCase
+	discover            *discoverfeeds.UseCase
+	exportFeed          *exportfeed.UseCase
+	timeline            *listtimeline.UseCase
+	remove              *deletefeed.UseCase
+	refresh             *refreshfeed.UseCase
+	history             *feedhistory.UseCase
+	listFolders         *listfolders.UseCase
+	createFolder        *createfolder.UseCase
+	renameFolder        *renamefolder.UseCase
+	deleteFolder        *deletefolder.UseCase
+	moveFeed            *movefeed.UseCase
+	tagFeed             *tagfeed.UseCase
+	listTags            *listtags.UseCase
+	catalog             *listcatalog.UseCase
+	addCategory         *addcatalogcategory.UseCase
+	addSource           *addcatalogsource.UseCase
+	toggleSource        *togglecatalogsource.UseCase
 }
 
 // NewHandler wires dependencies.
-func NewHandler(fetch *fetchfeed.UseCase, list *listfeeds.UseCase, clear *clearfeeds.UseCase) *Handler {
-	return &Handler{fetch: fetch, list: list, clear: clear}
+func NewHandler(uc UseCases) *Handler {
+	return &Handler{
+		fetch:               uc.Fetch,
+		list:                uc.List,
+		clear:               uc.Clear,
+		subscribe:           uc.Subscribe,
+		unsubscribe:         uc.Unsubscribe,
+		listSubscriptions:   uc.ListSubscriptions,
+		listItems:           uc.ListItems,
+		getItem:             uc.GetItem,
+		markItem:            uc.MarkItem,
+		markFeedRead:        uc.MarkFeedRead,
+		importSubscriptions: uc.ImportSubscriptions,
+		exportSubscriptions: uc.ExportSubscriptions,
+		search:              uc.Search,
+		listHosts:           uc.ListHosts,
+		discover:            uc.Discover,
+		exportFeed:          uc.ExportFeed,
+		timeline:            uc.Timeline,
+		remove:              uc.DeleteFeed,
+		refresh:             uc.RefreshFeed,
+		history:             uc.FeedHistory,
+		listFolders:         uc.ListFolders,
+		createFolder:        uc.CreateFolder,
+		renameFolder:        uc.RenameFolder,
+		deleteFolder:        uc.DeleteFolder,
+		moveFeed:            uc.MoveFeed,
+		tagFeed:             uc.TagFeed,
+		listTags:            uc.ListTags,
+		catalog:             uc.ListCatalog,
+		addCategory:         uc.AddCatalogCategory,
+		addSource:           uc.AddCatalogSource,
+		toggleSource:        uc.ToggleCatalogSource,
+	}
 }
 
 // Register mounts the routes on the provided ServeMux.
 func (h *Handler) Register(mux *stdhttp.ServeMux) {
 	mux.HandleFunc("/api/feed", h.getFeed)
-	mux.HandleFunc("/api/feeds/recent", h.handleRecentFeeds)
+	mux.HandleFunc("GET /api/feeds/recent", h.getRecentFeeds)
+	mux.HandleFunc("DELETE /api/feeds/recent", h.clearRecentFeeds)
+	mux.HandleFunc("GET /api/feeds/items", h.getFeedItems)
+	mux.HandleFunc("POST /api/feeds/read", h.markFeedAsRead)
+	mux.HandleFunc("DELETE /api/feeds/{ref}", h.deleteFeed)
+	mux.HandleFunc("POST /api/feeds/{id}/refresh", h.refreshFeed)
+	mux.HandleFunc("GET /api/feeds/{id}/history", h.getFeedHistory)
+	mux.HandleFunc("PUT /api/feeds/{id}/folder", h.fileFeed)
+	mux.HandleFunc("PUT /api/feeds/{id}/tags", h.setFeedTags)
+	mux.HandleFunc("GET /api/folders", h.getFolders)
+	mux.HandleFunc("POST /api/folders", h.addFolder)
+	mux.HandleFunc("PATCH /api/folders/{id}", h.updateFolder)
+	mux.HandleFunc("DELETE /api/folders/{id}", h.removeFolder)
+	mux.HandleFunc("GET /api/tags", h.getTags)
+	mux.HandleFunc("GET /api/items", h.getTimeline)
+	mux.HandleFunc("GET /api/items/{id}", h.getItemByID)
+	mux.HandleFunc("PATCH /api/items/{id}", h.updateItemState)
+	mux.HandleFunc("/api/subscriptions", h.handleSubscriptions)
+	mux.HandleFunc("/api/opml", h.handleOPML)
+	mux.HandleFunc("GET /api/search", h.searchItems)
+	mux.HandleFunc("GET /api/discover", h.discoverFeeds)
+	mux.HandleFunc("GET /api/output", h.renderFeed)
+	mux.HandleFunc("GET /api/catalog", h.getCatalog)
+	mux.HandleFunc("GET /api/admin/hosts", h.getHostStatuses)
+	mux.HandleFunc("GET /api/admin/catalog", h.getCatalogAdmin)
+	mux.HandleFunc("POST /api/admin/catalog/categories", h.createCatalogCategory)
+	mux.HandleFunc("POST /api/admin/catalog/sources", h.createCatalogSource)
+	mux.HandleFunc("PATCH /api/admin/catalog/sources/{id}", h.updateCatalogSource)
 }
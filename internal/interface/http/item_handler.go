@@ -0,0 +1,131 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+func (h *Handler) getFeedItems(w http.ResponseWriter, r *http.Request) {
+	if h.listItems == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+
+	items, err := h.listItems.Execute(r.Context(), query.Get("url"), limit, offset)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := make([]feedItemResp, 0, len(items))
+	for _, item := range items {
+		response = append(response, toItemResponse(item))
+	}
+
+	writeJSON(w, itemsResponse{Items: response})
+}
+
+func (h *Handler) getItemByID(w http.ResponseWriter, r *http.Request) {
+	if h.getItem == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, invalidInput("invalid item id"))
+		return
+	}
+
+	item, err := h.getItem.Execute(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, toItemResponse(*item))
+}
+
+func (h *Handler) updateItemState(w http.ResponseWriter, r *http.Request) {
+	if h.markItem == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, invalidInput("invalid item id"))
+		return
+	}
+
+	var req itemStateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, invalidInput("invalid request body"))
+		return
+	}
+
+	item, err := h.markItem.Execute(r.Context(), id, feed.StateChange{
+		Read:     req.Read,
+		Starred:  req.Starred,
+		Archived: req.Archived,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, toItemResponse(*item))
+}
+
+func (h *Handler) markFeedAsRead(w http.ResponseWriter, r *http.Request) {
+	if h.markFeedRead == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	var req markFeedReadRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, invalidInput("invalid request body"))
+		return
+	}
+
+	var olderThan time.Time
+	if req.OlderThan != nil {
+		olderThan = *req.OlderThan
+	}
+
+	updated, err := h.markFeedRead.Execute(r.Context(), req.URL, olderThan)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, markFeedReadResponse{Updated: updated})
+}
+
+type itemStateRequest struct {
+	Read     *bool `json:"read"`
+	Starred  *bool `json:"starred"`
+	Archived *bool `json:"archived"`
+}
+
+type markFeedReadRequest struct {
+	URL       string     `json:"url"`
+	OlderThan *time.Time `json:"olderThan"`
+}
+
+type markFeedReadResponse struct {
+	Updated int64 `json:"updated"`
+}
+
+type itemsResponse struct {
+	Items []feedItemResp `json:"items"`
+}
@@ -0,0 +1,104 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/infra/opml"
+)
+
+const maxOPMLSize = 5 << 20
+
+func (h *Handler) handleOPML(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.exportOPML(w, r)
+	case http.MethodPost:
+		h.importOPML(w, r)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *Handler) importOPML(w http.ResponseWriter, r *http.Request) {
+	if h.importSubscriptions == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	doc, err := opml.Parse(http.MaxBytesReader(w, r.Body, maxOPMLSize))
+	if err != nil {
+		writeError(w, fmt.Errorf("%w: %w", feed.ErrInvalidInput, err))
+		return
+	}
+
+	entries := doc.Entries()
+	outlines := make([]feed.Outline, 0, len(entries))
+	for _, entry := range entries {
+		outlines = append(outlines, feed.Outline{
+			Title:   entry.Title,
+			FeedURL: entry.XMLURL,
+			SiteURL: entry.HTMLURL,
+			Folder:  entry.Folder,
+		})
+	}
+
+	report, err := h.importSubscriptions.Execute(r.Context(), outlines)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := opmlImportResponse{
+		Imported: make([]subscriptionResponse, 0, len(report.Imported)),
+		Skipped:  make([]opmlSkippedResponse, 0, len(report.Skipped)),
+	}
+	for _, sub := range report.Imported {
+		response.Imported = append(response.Imported, toSubscriptionResponse(sub))
+	}
+	for _, skipped := range report.Skipped {
+		response.Skipped = append(response.Skipped, opmlSkippedResponse(skipped))
+	}
+
+	writeJSON(w, response)
+}
+
+func (h *Handler) exportOPML(w http.ResponseWriter, r *http.Request) {
+	if h.exportSubscriptions == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	outlines, err := h.exportSubscriptions.Execute(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	entries := make([]opml.Entry, 0, len(outlines))
+	for _, outline := range outlines {
+		entries = append(entries, opml.Entry{
+			Title:   outline.Title,
+			XMLURL:  outline.FeedURL,
+			HTMLURL: outline.SiteURL,
+			Folder:  outline.Folder,
+		})
+	}
+
+	w.Header().Set("Content-Type", "text/x-opml; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="rssreader.opml"`)
+	_ = opml.Write(w, opml.FromEntries("RSS Reader", time.Now().UTC(), entries))
+}
+
+type opmlImportResponse struct {
+	Imported []subscriptionResponse `json:"imported"`
+	Skipped  []opmlSkippedResponse  `json:"skipped"`
+}
+
+type opmlSkippedResponse struct {
+	URL    string `json:"url"`
+	Title  string `json:"title,omitempty"`
+	Reason string `json:"reason"`
+}
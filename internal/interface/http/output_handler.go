@@ -0,0 +1,70 @@
+package http
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/infra/syndication"
+	"rssreader/internal/usecase/exportfeed"
+)
+
+// renderFeed republishes a stored feed, or a folder of feeds, as JSON Feed, Atom or RSS.
+// The format parameter wins over the Accept header; JSON Feed is the default.
+func (h *Handler) renderFeed(w http.ResponseWriter, r *http.Request) {
+	if h.exportFeed == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	params := r.URL.Query()
+	format := strings.ToLower(strings.TrimSpace(params.Get("format")))
+	switch {
+	case format != "" && syndication.ContentType(format) == "":
+		writeError(w, fmt.Errorf("%w: unsupported format %q", feed.ErrInvalidInput, format))
+		return
+	case format == "":
+		format = syndication.Negotiate(r.Header.Get("Accept"))
+		if format == "" {
+			format = feed.FormatJSON
+		}
+	}
+
+	limit, _ := strconv.Atoi(params.Get("limit"))
+	result, err := h.exportFeed.Execute(r.Context(), exportfeed.Query{
+		URL:    params.Get("url"),
+		Folder: params.Get("folder"),
+		Limit:  limit,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var buf bytes.Buffer
+	opts := syndication.Options{SelfURL: selfURL(r), Generated: time.Now()}
+	if err := syndication.Write(&buf, format, result, opts); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", syndication.ContentType(format))
+	w.Header().Set("Vary", "Accept")
+	_, _ = buf.WriteTo(w)
+}
+
+// selfURL reconstructs the public URL of the request, honouring X-Forwarded-Proto.
+func selfURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if forwarded := r.Header.Get("X-Forwarded-Proto"); forwarded == "http" || forwarded == "https" {
+		scheme = forwarded
+	}
+	return scheme + "://" + r.Host + r.URL.RequestURI()
+}
@@ -0,0 +1,90 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+func (h *Handler) searchItems(w http.ResponseWriter, r *http.Request) {
+	if h.search == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	params := r.URL.Query()
+	from, err := parseDateParam(params.Get("from"), false)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	to, err := parseDateParam(params.Get("to"), true)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	limit, _ := strconv.Atoi(params.Get("limit"))
+	offset, _ := strconv.Atoi(params.Get("offset"))
+
+	hits, err := h.search.Execute(r.Context(), feed.SearchQuery{
+		Text:    params.Get("q"),
+		FeedURL: params.Get("feed"),
+		From:    from,
+		To:      to,
+		Limit:   limit,
+		Offset:  offset,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := make([]searchHitResponse, 0, len(hits))
+	for _, hit := range hits {
+		response = append(response, searchHitResponse{
+			Item:         toItemResponse(hit.Item),
+			FeedURL:      hit.Item.FeedURL,
+			Rank:         hit.Rank,
+			TitleSnippet: hit.TitleSnippet,
+			Snippet:      hit.Snippet,
+		})
+	}
+
+	writeJSON(w, searchResponse{Results: response})
+}
+
+// parseDateParam accepts RFC 3339 timestamps or plain dates; a plain date used as an
+// upper bound covers the whole day.
+func parseDateParam(value string, endOfDay bool) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+	parsed, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid date %q", feed.ErrInvalidInput, value)
+	}
+	if endOfDay {
+		parsed = parsed.AddDate(0, 0, 1)
+	}
+	return parsed, nil
+}
+
+type searchResponse struct {
+	Results []searchHitResponse `json:"results"`
+}
+
+type searchHitResponse struct {
+	Item         feedItemResp `json:"item"`
+	FeedURL      string       `json:"feedUrl"`
+	Rank         float64      `json:"rank"`
+	TitleSnippet string       `json:"titleSnippet"`
+	Snippet      string       `json:"snippet"`
+}
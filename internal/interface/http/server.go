@@ -7,21 +7,31 @@ import (
 	"time"
 )
 
+// writeTimeout bounds how long a handler may take to write its response; handlers
+// that need longer extend their own deadline with withWriteBudget.
+const writeTimeout = 10 * time.Second
+
 // Server wraps the HTTP server configuration.
 type Server struct {
 	srv *http.Server
 }
 
 // NewServer configures an HTTP server using the provided handler registration function.
-func NewServer(addr string, register func(mux *http.ServeMux)) *Server {
+// The middleware wraps the routes, the first one outermost.
+func NewServer(addr string, register func(mux *http.ServeMux), middleware ...func(http.Handler) http.Handler) *Server {
 	mux := http.NewServeMux()
 	register(mux)
 
+	var handler http.Handler = mux
+	for i := len(middleware) - 1; i >= 0; i-- {
+		handler = middleware[i](handler)
+	}
+
 	srv := &http.Server{
 		Addr:              addr,
-		Handler:           mux,
+		Handler:           handler,
 		ReadHeaderTimeout: 5 * time.Second,
-		WriteTimeout:      10 * time.Second,
+		WriteTimeout:      writeTimeout,
 		IdleTimeout:       60 * time.Second,
 	}
 
@@ -41,3 +51,11 @@ func (s *Server) ListenAndServe() error {
 func (s *Server) Shutdown(ctx context.Context) error {
 	return s.srv.Shutdown(ctx)
 }
+
+// withWriteBudget bounds the request context by budget and pushes the response write
+// deadline past it, so a handler allowed more than writeTimeout can still answer.
+func withWriteBudget(w http.ResponseWriter, r *http.Request, budget time.Duration) (context.Context, context.CancelFunc) {
+	// Writers that cannot extend the deadline (e.g. in tests) simply keep theirs.
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Now().Add(budget + writeTimeout))
+	return context.WithTimeout(r.Context(), budget)
+}
@@ -0,0 +1,114 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+func (h *Handler) handleSubscriptions(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.getSubscriptions(w, r)
+	case http.MethodPost:
+		h.createSubscription(w, r)
+	case http.MethodDelete:
+		h.deleteSubscription(w, r)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *Handler) getSubscriptions(w http.ResponseWriter, r *http.Request) {
+	if h.listSubscriptions == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	subs, err := h.listSubscriptions.Execute(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := make([]subscriptionResponse, 0, len(subs))
+	for _, sub := range subs {
+		response = append(response, toSubscriptionResponse(sub))
+	}
+
+	writeJSON(w, subscriptionsResponse{Subscriptions: response})
+}
+
+func (h *Handler) createSubscription(w http.ResponseWriter, r *http.Request) {
+	if h.subscribe == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	var req subscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, invalidInput("invalid request body"))
+		return
+	}
+
+	sub, err := h.subscribe.Execute(r.Context(), req.URL, time.Duration(req.IntervalSeconds)*time.Second)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(toSubscriptionResponse(*sub))
+}
+
+func (h *Handler) deleteSubscription(w http.ResponseWriter, r *http.Request) {
+	if h.unsubscribe == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	if err := h.unsubscribe.Execute(r.Context(), r.URL.Query().Get("url")); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type subscriptionRequest struct {
+	URL             string `json:"url"`
+	IntervalSeconds int64  `json:"intervalSeconds"`
+}
+
+type subscriptionsResponse struct {
+	Subscriptions []subscriptionResponse `json:"subscriptions"`
+}
+
+type subscriptionResponse struct {
+	ID              int64      `json:"id"`
+	SourceURL       string     `json:"sourceUrl"`
+	Title           string     `json:"title,omitempty"`
+	Folder          string     `json:"folder,omitempty"`
+	IntervalSeconds int64      `json:"intervalSeconds"`
+	LastPolledAt    *time.Time `json:"lastPolledAt,omitempty"`
+	NextPollAt      time.Time  `json:"nextPollAt"`
+	LastError       string     `json:"lastError,omitempty"`
+	CreatedAt       time.Time  `json:"createdAt"`
+}
+
+func toSubscriptionResponse(sub feed.Subscription) subscriptionResponse {
+	return subscriptionResponse{
+		ID:              sub.ID,
+		SourceURL:       sub.SourceURL,
+		Title:           sub.Title,
+		Folder:          sub.Folder,
+		IntervalSeconds: int64(sub.Interval / time.Second),
+		LastPolledAt:    optionalTime(sub.LastPolledAt),
+		NextPollAt:      sub.NextPollAt,
+		LastError:       sub.LastError,
+		CreatedAt:       sub.CreatedAt,
+	}
+}
@@ -0,0 +1,77 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/usecase/listtimeline"
+)
+
+func (h *Handler) getTimeline(w http.ResponseWriter, r *http.Request) {
+	if h.timeline == nil {
+		writeError(w, http.ErrNotSupported)
+		return
+	}
+
+	params := r.URL.Query()
+	query := listtimeline.Query{
+		Feed:   params.Get("feed"),
+		Folder: params.Get("folder"),
+		Tag:    params.Get("tag"),
+		Cursor: params.Get("cursor"),
+	}
+	query.Limit, _ = strconv.Atoi(params.Get("limit"))
+	if raw := params.Get("folderId"); raw != "" {
+		var err error
+		if query.FolderID, err = strconv.ParseInt(raw, 10, 64); err != nil {
+			writeError(w, invalidInput("invalid folder id"))
+			return
+		}
+	}
+
+	yes, no := true, false
+	switch state := params.Get("state"); state {
+	case "", "all":
+	case "unread":
+		query.Read = &no
+	case "read":
+		query.Read = &yes
+	case "starred":
+		query.Starred = &yes
+	default:
+		writeError(w, fmt.Errorf("%w: unknown state %q", feed.ErrInvalidInput, state))
+		return
+	}
+
+	var err error
+	if query.From, err = parseDateParam(params.Get("from"), false); err != nil {
+		writeError(w, err)
+		return
+	}
+	if query.To, err = parseDateParam(params.Get("to"), true); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	page, err := h.timeline.Execute(r.Context(), query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := timelineResponse{Items: make([]feedItemResp, 0, len(page.Items)), NextCursor: page.NextCursor}
+	for _, item := range page.Items {
+		entry := toItemResponse(item)
+		entry.FeedURL = item.FeedURL
+		response.Items = append(response.Items, entry)
+	}
+
+	writeJSON(w, response)
+}
+
+type timelineResponse struct {
+	Items      []feedItemResp `json:"items"`
+	NextCursor string         `json:"nextCursor,omitempty"`
+}
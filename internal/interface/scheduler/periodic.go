@@ -0,0 +1,23 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+)
+
+// Every runs task right away and then once per interval until ctx is cancelled. Runs
+// never overlap: a slow run delays the next one instead of stacking up.
+func Every(ctx context.Context, interval time.Duration, task func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		task(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
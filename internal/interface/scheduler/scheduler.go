@@ -0,0 +1,205 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+	"rssreader/internal/usecase/fetchfeed"
+)
+
+// Poller refreshes a single feed URL.
+type Poller interface {
+	Execute(ctx context.Context, url string) (*fetchfeed.Result, error)
+}
+
+// Config tunes how often and how concurrently subscriptions are polled.
+type Config struct {
+	// Tick is how often the store is checked for due subscriptions.
+	Tick time.Duration
+	// Workers bounds the number of feeds fetched concurrently.
+	Workers int
+	// BatchSize caps how many due subscriptions are loaded per tick.
+	BatchSize int
+	// PollTimeout bounds a single feed refresh.
+	PollTimeout time.Duration
+}
+
+// Scheduler polls subscribed feeds on their own interval using a bounded worker pool.
+type Scheduler struct {
+	store  repository.SubscriptionStore
+	poller Poller
+	cfg    Config
+	clock  func() time.Time
+
+	mu       sync.Mutex
+	inflight map[int64]struct{}
+	queued   int
+	polling  int
+}
+
+// Stats reports the subscriptions the scheduler has claimed.
+type Stats struct {
+	// Queued subscriptions are due in the current batch and waiting for a free worker.
+	Queued int
+	// Polling subscriptions are being fetched.
+	Polling int
+}
+
+// New wires a Scheduler, applying defaults to zero config values.
+func New(store repository.SubscriptionStore, poller Poller, cfg Config, clock func() time.Time) *Scheduler {
+	if cfg.Tick <= 0 {
+		cfg.Tick = 30 * time.Second
+	}
+	if cfg.Workers <= 0 {
+		cfg.Workers = 4
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 100
+	}
+	if cfg.PollTimeout <= 0 {
+		cfg.PollTimeout = 30 * time.Second
+	}
+	if clock == nil {
+		clock = time.Now
+	}
+
+	return &Scheduler{
+		store:    store,
+		poller:   poller,
+		cfg:      cfg,
+		clock:    clock,
+		inflight: make(map[int64]struct{}),
+	}
+}
+
+// Run polls due subscriptions until ctx is cancelled, then waits for in-flight polls to finish.
+func (s *Scheduler) Run(ctx context.Context) error {
+	if s.store == nil || s.poller == nil {
+		return errors.New("scheduler dependencies not configured")
+	}
+
+	jobs := make(chan feed.Subscription)
+	var wg sync.WaitGroup
+	for i := 0; i < s.cfg.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for sub := range jobs {
+				s.poll(ctx, sub)
+			}
+		}()
+	}
+
+	ticker := time.NewTicker(s.cfg.Tick)
+	defer ticker.Stop()
+
+	defer func() {
+		close(jobs)
+		wg.Wait()
+	}()
+
+	for {
+		s.dispatch(ctx, jobs)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) dispatch(ctx context.Context, jobs chan<- feed.Subscription) {
+	due, err := s.store.ListDue(ctx, s.clock(), s.cfg.BatchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("scheduler: list due subscriptions: %v", err)
+		}
+		return
+	}
+
+	s.setQueued(len(due))
+	defer s.setQueued(0)
+	for i, sub := range due {
+		if s.claim(sub.ID) {
+			select {
+			case jobs <- sub:
+			case <-ctx.Done():
+				s.release(sub.ID)
+				return
+			}
+		}
+		s.setQueued(len(due) - i - 1)
+	}
+}
+
+// Stats returns a snapshot of the scheduler queue.
+func (s *Scheduler) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return Stats{Queued: s.queued, Polling: s.polling}
+}
+
+func (s *Scheduler) setQueued(n int) {
+	s.mu.Lock()
+	s.queued = n
+	s.mu.Unlock()
+}
+
+func (s *Scheduler) poll(ctx context.Context, sub feed.Subscription) {
+	s.mu.Lock()
+	s.polling++
+	s.mu.Unlock()
+	defer s.finish(sub.ID)
+
+	pollCtx, cancel := context.WithTimeout(ctx, s.cfg.PollTimeout)
+	res, pollErr := s.poller.Execute(pollCtx, sub.SourceURL)
+	cancel()
+	if pollErr == nil && res.Stale {
+		// The stored snapshot hid a failed download; the subscription still failed.
+		pollErr = res.UpstreamErr
+	}
+
+	if ctx.Err() != nil {
+		// Shutting down: leave the subscription due so it is picked up on the next start.
+		return
+	}
+	if pollErr != nil {
+		log.Printf("scheduler: poll %s: %v", sub.SourceURL, pollErr)
+	}
+
+	markCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if err := s.store.MarkPolled(markCtx, sub.ID, s.clock(), pollErr); err != nil {
+		log.Printf("scheduler: mark %s polled: %v", sub.SourceURL, err)
+	}
+}
+
+func (s *Scheduler) claim(id int64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, busy := s.inflight[id]; busy {
+		return false
+	}
+	s.inflight[id] = struct{}{}
+	return true
+}
+
+func (s *Scheduler) release(id int64) {
+	s.mu.Lock()
+	delete(s.inflight, id)
+	s.mu.Unlock()
+}
+
+// finish releases a subscription once its poll is over.
+func (s *Scheduler) finish(id int64) {
+	s.mu.Lock()
+	delete(s.inflight, id)
+	s.polling--
+	s.mu.Unlock()
+}
@@ -0,0 +1,213 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/interface/scheduler"
+	"rssreader/internal/usecase/fetchfeed"
+)
+
+type storeStub struct {
+	mu     sync.Mutex
+	due    []feed.Subscription
+	polled map[int64]error
+}
+
+func (s *storeStub) Subscribe(ctx context.Context, entry *feed.Subscription) error {
+	return nil
+}
+
+func (s *storeStub) Unsubscribe(ctx context.Context, url string) error {
+	return nil
+}
+
+func (s *storeStub) List(ctx context.Context) ([]feed.Subscription, error) {
+	return nil, nil
+}
+
+func (s *storeStub) ListDue(ctx context.Context, now time.Time, limit int) ([]feed.Subscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var due []feed.Subscription
+	for _, sub := range s.due {
+		if _, done := s.polled[sub.ID]; !done {
+			due = append(due, sub)
+		}
+	}
+	return due, nil
+}
+
+func (s *storeStub) MarkPolled(ctx context.Context, id int64, polledAt time.Time, pollErr error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.polled[id] = pollErr
+	return nil
+}
+
+func (s *storeStub) polledCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.polled)
+}
+
+type pollerStub struct {
+	active   atomic.Int32
+	peak     atomic.Int32
+	calls    atomic.Int32
+	failURL  string
+	staleURL string
+}
+
+func (p *pollerStub) Execute(ctx context.Context, url string) (*fetchfeed.Result, error) {
+	p.calls.Add(1)
+	n := p.active.Add(1)
+	defer p.active.Add(-1)
+	for {
+		peak := p.peak.Load()
+		if n <= peak || p.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	switch url {
+	case p.failURL:
+		return nil, errors.New("network down")
+	case p.staleURL:
+		return &fetchfeed.Result{Feed: &feed.Feed{SourceURL: url}, Stale: true, UpstreamErr: errors.New("timeout")}, nil
+	}
+	return &fetchfeed.Result{Feed: &feed.Feed{SourceURL: url}}, nil
+}
+
+func TestRunPollsDueSubscriptionsWithBoundedWorkers(t *testing.T) {
+	store := &storeStub{polled: make(map[int64]error)}
+	for i := int64(1); i <= 6; i++ {
+		store.due = append(store.due, feed.Subscription{ID: i, SourceURL: "https://example.com/" + string(rune('a'+i))})
+	}
+	store.due[0].SourceURL = "https://broken.example.com/rss"
+	store.due[1].SourceURL = "https://stale.example.com/rss"
+	poller := &pollerStub{failURL: "https://broken.example.com/rss", staleURL: "https://stale.example.com/rss"}
+
+	s := scheduler.New(store, poller, scheduler.Config{Tick: 5 * time.Millisecond, Workers: 2}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- s.Run(ctx) }()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for store.polledCount() < len(store.due) {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected all subscriptions to be polled, got %d", store.polledCount())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("scheduler did not stop after cancellation")
+	}
+
+	if peak := poller.peak.Load(); peak > 2 {
+		t.Errorf("expected at most 2 concurrent polls, got %d", peak)
+	}
+	if calls := poller.calls.Load(); calls != int32(len(store.due)) {
+		t.Errorf("expected each subscription to be polled once, got %d calls", calls)
+	}
+	if store.polled[1] == nil {
+		t.Error("expected poll error to be recorded")
+	}
+	if store.polled[2] == nil {
+		t.Error("expected the failure behind a stale snapshot to be recorded")
+	}
+	if store.polled[3] != nil {
+		t.Errorf("expected a successful poll, got %v", store.polled[3])
+	}
+}
+
+func TestRunRequiresDependencies(t *testing.T) {
+	s := scheduler.New(nil, nil, scheduler.Config{}, nil)
+	if err := s.Run(context.Background()); err == nil {
+		t.Fatal("expected error when dependencies are missing")
+	}
+}
+
+func TestEveryRunsUntilCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var runs atomic.Int32
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		scheduler.Every(ctx, time.Millisecond, func(ctx context.Context) {
+			if runs.Add(1) == 3 {
+				cancel()
+			}
+		})
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected Every to return once the context is cancelled")
+	}
+	if got := runs.Load(); got < 3 {
+		t.Fatalf("expected at least 3 runs, got %d", got)
+	}
+}
+
+type gatedPoller struct {
+	started chan string
+	release chan struct{}
+}
+
+func (p *gatedPoller) Execute(ctx context.Context, url string) (*fetchfeed.Result, error) {
+	p.started <- url
+	<-p.release
+	return &fetchfeed.Result{Feed: &feed.Feed{SourceURL: url}}, nil
+}
+
+func TestStatsReportsQueuedAndPollingSubscriptions(t *testing.T) {
+	store := &storeStub{polled: make(map[int64]error)}
+	for i := int64(1); i <= 3; i++ {
+		store.due = append(store.due, feed.Subscription{ID: i, SourceURL: "https://example.com/" + string(rune('a'+i))})
+	}
+	poller := &gatedPoller{started: make(chan string), release: make(chan struct{})}
+	s := scheduler.New(store, poller, scheduler.Config{Tick: time.Hour, Workers: 1}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- s.Run(ctx) }()
+
+	<-poller.started
+	deadline := time.Now().Add(2 * time.Second)
+	for s.Stats() != (scheduler.Stats{Queued: 2, Polling: 1}) {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected two queued and one polling subscription, got %+v", s.Stats())
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+	close(poller.release)
+	go func() {
+		for range poller.started {
+		}
+	}()
+	if err := <-done; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	close(poller.started)
+	if stats := s.Stats(); stats != (scheduler.Stats{}) {
+		t.Errorf("expected an empty queue after stopping, got %+v", stats)
+	}
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+// CatalogStore persists the curated catalog of suggested sources. A source is unique
+// within its category by URL; category ids are unique slugs. Adding a duplicate fails
+// with feed.ErrConflict.
+type CatalogStore interface {
+	// SeedCatalog adds the categories and sources that are not stored yet, leaving
+	// existing entries, including disabled ones, untouched.
+	SeedCatalog(ctx context.Context, categories []feed.CatalogCategory) error
+	// ListCatalog returns the categories in the order they were added, each with its
+	// sources; disabled sources are only included when includeDisabled is set.
+	ListCatalog(ctx context.Context, includeDisabled bool) ([]feed.CatalogCategory, error)
+	// AddCatalogCategory stores a new category, ignoring its Sources.
+	AddCatalogCategory(ctx context.Context, category feed.CatalogCategory) (*feed.CatalogCategory, error)
+	// AddCatalogSource stores a new source and returns it, or nil when its category does
+	// not exist.
+	AddCatalogSource(ctx context.Context, source feed.CatalogSource) (*feed.CatalogSource, error)
+	// SetCatalogSourceDisabled hides or restores the source and returns it, or nil when
+	// it does not exist.
+	SetCatalogSourceDisabled(ctx context.Context, id int64, disabled bool) (*feed.CatalogSource, error)
+	// CatalogURLs returns the distinct URLs of the enabled sources.
+	CatalogURLs(ctx context.Context) ([]string, error)
+	// RecordCatalogCheck stores the outcome of a health check on every source with the
+	// URL; an empty checkErr marks a successful check and resets the failure count.
+	RecordCatalogCheck(ctx context.Context, url string, checkedAt time.Time, checkErr string) error
+}
@@ -2,23 +2,49 @@ package repository
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"rssreader/internal/domain/feed"
 )
 
+// FetchResult holds the raw feed payload along with the publisher cache validators.
+type FetchResult struct {
+	// Body streams the payload; it is nil when NotModified is set and must otherwise be closed.
+	Body         io.ReadCloser
+	StatusCode   int
+	ETag         string
+	LastModified string
+	// NotModified reports that the publisher answered a conditional request with 304.
+	NotModified bool
+	// MaxAge is the freshness lifetime granted by the publisher's Cache-Control header.
+	// HasMaxAge is false when the header grants none; no-cache grants zero.
+	MaxAge    time.Duration
+	HasMaxAge bool
+}
+
 // FeedFetcher abstracts fetching raw feed data from an external source.
 type FeedFetcher interface {
-	Fetch(ctx context.Context, url string) ([]byte, error)
+	// Fetch downloads the feed, sending the validators of a previous fetch when present.
+	Fetch(ctx context.Context, url string, validators feed.Validators) (*FetchResult, error)
 }
 
 // FeedStore persists feed snapshots for later retrieval.
 type FeedStore interface {
 	// Save stores or updates the feed snapshot for the given source URL.
 	Save(ctx context.Context, entry *feed.Feed) error
-	// ListRecent returns the most recent feeds ordered by fetched at descending.
-	ListRecent(ctx context.Context, limit int) ([]feed.Summary, error)
+	// ListRecent returns a page of stored feeds in the query's sort order, most recently
+	// fetched first by default.
+	ListRecent(ctx context.Context, query feed.RecentQuery) ([]feed.Summary, error)
 	// FindByURL returns the latest stored feed for a given URL, if any.
 	FindByURL(ctx context.Context, url string) (*feed.Feed, error)
+	// LookupURL returns the source URL of the feed with the given id, or "" when none is stored.
+	LookupURL(ctx context.Context, id int64) (string, error)
+	// MarkChecked records that the publisher confirmed the stored snapshot is still
+	// current, along with its renewed freshness lifetime.
+	MarkChecked(ctx context.Context, url string, checkedAt time.Time, ttl time.Duration) error
+	// Delete removes the feed and its items, reporting whether it was stored.
+	Delete(ctx context.Context, url string) (bool, error)
 	// Clear removes all stored feed snapshots.
 	Clear(ctx context.Context) error
 }
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"rssreader/internal/domain/feed"
+)
+
+// FetchLogStore keeps the latest fetch attempts of each stored feed.
+type FetchLogStore interface {
+	// RecordFetch appends the attempt, discarding the oldest attempts of its URL beyond
+	// feed.FetchLogRetention.
+	RecordFetch(ctx context.Context, attempt feed.FetchAttempt) error
+	// FetchHistory returns up to limit attempts of each URL, newest first, keyed by URL.
+	// URLs without attempts are absent from the result.
+	FetchHistory(ctx context.Context, urls []string, limit int) (map[string][]feed.FetchAttempt, error)
+	// DeleteFetchHistory removes every attempt of the URL.
+	DeleteFetchHistory(ctx context.Context, url string) error
+	// ClearFetchHistory removes every attempt.
+	ClearFetchHistory(ctx context.Context) error
+}
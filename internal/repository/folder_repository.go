@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+
+	"rssreader/internal/domain/feed"
+)
+
+// FolderStore organizes stored feeds into folders. Folder names are unique regardless
+// of case; creating or renaming onto a taken name fails with feed.ErrConflict.
+type FolderStore interface {
+	// CreateFolder stores a new empty folder and returns it.
+	CreateFolder(ctx context.Context, name string) (*feed.Folder, error)
+	// ListFolders returns every folder with its feed and unread item counts, ordered by name.
+	ListFolders(ctx context.Context) ([]feed.Folder, error)
+	// RenameFolder renames the folder and returns it, or nil when it does not exist.
+	RenameFolder(ctx context.Context, id int64, name string) (*feed.Folder, error)
+	// DeleteFolder removes the folder, leaving its feeds unfiled, and reports whether it existed.
+	DeleteFolder(ctx context.Context, id int64) (bool, error)
+	// MoveFeed files the feed in the folder, or unfiles it when folderID is zero. It
+	// reports false when the feed or the folder does not exist.
+	MoveFeed(ctx context.Context, feedID, folderID int64) (bool, error)
+	// FolderFeedURLs returns the source URLs of the feeds filed in the folder, or nil
+	// when the folder does not exist.
+	FolderFeedURLs(ctx context.Context, id int64) ([]string, error)
+}
+
+// TagStore labels stored feeds with free-form tags.
+type TagStore interface {
+	// SetFeedTags replaces the tags of the feed and reports whether the feed exists.
+	SetFeedTags(ctx context.Context, feedID int64, tags []string) (bool, error)
+	// ListTags returns every tag in use with the number of feeds carrying it, ordered by tag.
+	ListTags(ctx context.Context) ([]feed.TagCount, error)
+	// TagFeedURLs returns the source URLs of the feeds carrying the tag.
+	TagFeedURLs(ctx context.Context, tag string) ([]string, error)
+}
@@ -0,0 +1,9 @@
+package repository
+
+import "rssreader/internal/domain/feed"
+
+// HostStatusReader reports how the fetcher currently treats publisher hosts.
+type HostStatusReader interface {
+	// HostStatuses returns the hosts with recent failures, ordered by host name.
+	HostStatuses() []feed.HostStatus
+}
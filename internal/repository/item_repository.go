@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"rssreader/internal/domain/feed"
+)
+
+// ItemStore gives access to the individual items accumulated for stored feeds.
+type ItemStore interface {
+	// ListItems returns a page of items for the feed ordered by published at descending.
+	ListItems(ctx context.Context, sourceURL string, limit, offset int) ([]feed.Item, error)
+	// FindItem returns the stored item with the given identifier, if any.
+	FindItem(ctx context.Context, id int64) (*feed.Item, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+// ItemStateStore mutates the per-item reading state.
+type ItemStateStore interface {
+	// UpdateItemState applies the change to the item and returns it, or nil when it does not exist.
+	UpdateItemState(ctx context.Context, id int64, change feed.StateChange) (*feed.Item, error)
+	// MarkFeedRead marks the unread items of the feed as read, restricted to items published
+	// before olderThan unless it is zero, and returns how many items changed.
+	MarkFeedRead(ctx context.Context, sourceURL string, olderThan time.Time) (int64, error)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"rssreader/internal/domain/feed"
+)
+
+// SearchStore runs full-text searches over stored items.
+type SearchStore interface {
+	// Search returns the items matching the query ordered by relevance.
+	Search(ctx context.Context, query feed.SearchQuery) ([]feed.SearchHit, error)
+}
@@ -0,0 +1,183 @@
+package storetest
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// TestCatalogStore runs the CatalogStore conformance suite. newStore must return an
+// empty store each time it is called.
+func TestCatalogStore(t *testing.T, newStore func(t *testing.T) repository.CatalogStore) {
+	t.Helper()
+
+	seed := []feed.CatalogCategory{
+		{ID: "noticias", Name: "Notícias", Description: "Jornais", Sources: []feed.CatalogSource{
+			{Name: "G1", URL: "https://g1.example/rss", Description: "Geral"},
+			{Name: "Canaltech", URL: "https://canaltech.example/rss"},
+		}},
+		{ID: "tecnologia", Name: "Tecnologia", Sources: []feed.CatalogSource{
+			{Name: "Canaltech", URL: "https://canaltech.example/rss"},
+		}},
+	}
+
+	// names flattens the catalog into "category/source" pairs.
+	names := func(t *testing.T, store repository.CatalogStore, includeDisabled bool) []string {
+		t.Helper()
+		categories, err := store.ListCatalog(context.Background(), includeDisabled)
+		if err != nil {
+			t.Fatalf("list catalog: %v", err)
+		}
+		names := []string{}
+		for _, category := range categories {
+			names = append(names, category.ID)
+			for _, source := range category.Sources {
+				if source.ID == 0 || source.CategoryID != category.ID || source.CreatedAt.IsZero() {
+					t.Fatalf("unexpected source %+v", source)
+				}
+				names = append(names, category.ID+"/"+source.Name)
+			}
+		}
+		return names
+	}
+
+	t.Run("SeedIsIdempotent", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		for i := 0; i < 2; i++ {
+			if err := store.SeedCatalog(ctx, seed); err != nil {
+				t.Fatalf("seed: %v", err)
+			}
+		}
+
+		want := []string{"noticias", "noticias/G1", "noticias/Canaltech", "tecnologia", "tecnologia/Canaltech"}
+		if got := names(t, store, false); !reflect.DeepEqual(got, want) {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+
+		categories, err := store.ListCatalog(ctx, false)
+		if err != nil {
+			t.Fatalf("list catalog: %v", err)
+		}
+		if got := categories[0]; got.Name != "Notícias" || got.Description != "Jornais" || got.Sources[0].Description != "Geral" {
+			t.Fatalf("unexpected category %+v", got)
+		}
+	})
+
+	t.Run("AddEntries", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		if err := store.SeedCatalog(ctx, seed); err != nil {
+			t.Fatalf("seed: %v", err)
+		}
+
+		if _, err := store.AddCatalogCategory(ctx, feed.CatalogCategory{ID: "noticias", Name: "Outra"}); !errors.Is(err, feed.ErrConflict) {
+			t.Fatalf("expected conflict for a taken category id, got %v", err)
+		}
+		category, err := store.AddCatalogCategory(ctx, feed.CatalogCategory{ID: "esportes", Name: "Esportes"})
+		if err != nil || category.ID != "esportes" {
+			t.Fatalf("add category: %+v %v", category, err)
+		}
+
+		source, err := store.AddCatalogSource(ctx, feed.CatalogSource{CategoryID: "esportes", Name: "GE", URL: "https://ge.example/rss"})
+		if err != nil || source == nil || source.ID == 0 || source.Disabled || source.CreatedAt.IsZero() {
+			t.Fatalf("add source: %+v %v", source, err)
+		}
+		if _, err := store.AddCatalogSource(ctx, feed.CatalogSource{CategoryID: "esportes", Name: "GE", URL: "https://ge.example/rss"}); !errors.Is(err, feed.ErrConflict) {
+			t.Fatalf("expected conflict for a duplicate url, got %v", err)
+		}
+		if missing, err := store.AddCatalogSource(ctx, feed.CatalogSource{CategoryID: "unknown", Name: "X", URL: "https://x.example/rss"}); err != nil || missing != nil {
+			t.Fatalf("expected nil for an unknown category, got %+v %v", missing, err)
+		}
+
+		want := []string{"noticias", "noticias/G1", "noticias/Canaltech", "tecnologia", "tecnologia/Canaltech", "esportes", "esportes/GE"}
+		if got := names(t, store, false); !reflect.DeepEqual(got, want) {
+			t.Fatalf("expected new entries last, got %v", got)
+		}
+	})
+
+	t.Run("DisabledSourcesSurviveSeeding", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		if err := store.SeedCatalog(ctx, seed); err != nil {
+			t.Fatalf("seed: %v", err)
+		}
+		categories, err := store.ListCatalog(ctx, false)
+		if err != nil {
+			t.Fatalf("list catalog: %v", err)
+		}
+		g1 := categories[0].Sources[0]
+
+		disabled, err := store.SetCatalogSourceDisabled(ctx, g1.ID, true)
+		if err != nil || disabled == nil || !disabled.Disabled || disabled.Name != "G1" {
+			t.Fatalf("disable: %+v %v", disabled, err)
+		}
+		if missing, err := store.SetCatalogSourceDisabled(ctx, g1.ID+100, true); err != nil || missing != nil {
+			t.Fatalf("expected nil for an unknown source, got %+v %v", missing, err)
+		}
+		if err := store.SeedCatalog(ctx, seed); err != nil {
+			t.Fatalf("reseed: %v", err)
+		}
+
+		if got := names(t, store, false); !reflect.DeepEqual(got, []string{"noticias", "noticias/Canaltech", "tecnologia", "tecnologia/Canaltech"}) {
+			t.Fatalf("expected G1 to stay hidden, got %v", got)
+		}
+		if got := names(t, store, true); len(got) != 5 {
+			t.Fatalf("expected disabled sources when asked for, got %v", got)
+		}
+		urls, err := store.CatalogURLs(ctx)
+		if err != nil {
+			t.Fatalf("catalog urls: %v", err)
+		}
+		if !reflect.DeepEqual(urls, []string{"https://canaltech.example/rss"}) {
+			t.Fatalf("expected distinct enabled urls, got %v", urls)
+		}
+
+		if restored, err := store.SetCatalogSourceDisabled(ctx, g1.ID, false); err != nil || restored.Disabled {
+			t.Fatalf("restore: %+v %v", restored, err)
+		}
+	})
+
+	t.Run("RecordChecksPerURL", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		if err := store.SeedCatalog(ctx, seed); err != nil {
+			t.Fatalf("seed: %v", err)
+		}
+
+		url := "https://canaltech.example/rss"
+		for i := 0; i < feed.CatalogBrokenAfter; i++ {
+			if err := store.RecordCatalogCheck(ctx, url, baseTime, "upstream status 404"); err != nil {
+				t.Fatalf("record check: %v", err)
+			}
+		}
+
+		categories, err := store.ListCatalog(ctx, false)
+		if err != nil {
+			t.Fatalf("list catalog: %v", err)
+		}
+		for _, source := range []feed.CatalogSource{categories[0].Sources[1], categories[1].Sources[0]} {
+			if !source.Broken() || source.CheckError != "upstream status 404" || !source.CheckedAt.Equal(baseTime) {
+				t.Fatalf("expected every listing of the url to be broken, got %+v", source)
+			}
+		}
+		if g1 := categories[0].Sources[0]; g1.Broken() || !g1.CheckedAt.IsZero() {
+			t.Fatalf("expected other urls untouched, got %+v", g1)
+		}
+
+		if err := store.RecordCatalogCheck(ctx, url, baseTime.Add(1), ""); err != nil {
+			t.Fatalf("record check: %v", err)
+		}
+		categories, err = store.ListCatalog(ctx, false)
+		if err != nil {
+			t.Fatalf("list catalog: %v", err)
+		}
+		if source := categories[1].Sources[0]; source.Broken() || source.CheckFailures != 0 || source.CheckError != "" {
+			t.Fatalf("expected a successful check to clear the failures, got %+v", source)
+		}
+	})
+}
@@ -0,0 +1,495 @@
+// Package storetest provides conformance tests that every repository backend must pass.
+package storetest
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// TestFeedStore runs the FeedStore conformance suite. newStore must return an empty
+// store each time it is called.
+func TestFeedStore(t *testing.T, newStore func(t *testing.T) repository.FeedStore) {
+	t.Helper()
+
+	t.Run("FindByURLUnknown", func(t *testing.T) {
+		store := newStore(t)
+		for _, url := range []string{"https://example.com/missing.xml", "   "} {
+			found, err := store.FindByURL(context.Background(), url)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if found != nil {
+				t.Fatalf("expected nil feed for %q, got %+v", url, found)
+			}
+		}
+	})
+
+	t.Run("SaveRequiresSourceURL", func(t *testing.T) {
+		store := newStore(t)
+		if err := store.Save(context.Background(), nil); err == nil {
+			t.Error("expected error for nil feed")
+		}
+		if err := store.Save(context.Background(), &feed.Feed{SourceURL: "  "}); err == nil {
+			t.Error("expected error for blank source url")
+		}
+	})
+
+	t.Run("SaveAndFindRoundTrip", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		entry := sampleFeed("  https://example.com/rss.xml  ", baseTime)
+
+		if err := store.Save(ctx, entry); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+		for _, item := range entry.Items {
+			if item.ID == 0 {
+				t.Fatalf("expected saved items to receive identifiers, got %+v", item)
+			}
+		}
+
+		found, err := store.FindByURL(ctx, "https://example.com/rss.xml ")
+		if err != nil {
+			t.Fatalf("find: %v", err)
+		}
+		if found == nil {
+			t.Fatal("expected stored feed")
+		}
+
+		if found.SourceURL != "https://example.com/rss.xml" {
+			t.Errorf("expected trimmed source url, got %q", found.SourceURL)
+		}
+		if found.Title != entry.Title || found.Description != entry.Description || found.Link != entry.Link || found.Image != entry.Image {
+			t.Errorf("metadata mismatch: %+v", found)
+		}
+		if !reflect.DeepEqual(found.Authors, entry.Authors) || !reflect.DeepEqual(found.Categories, entry.Categories) {
+			t.Errorf("expected authors %v and categories %v, got %v and %v", entry.Authors, entry.Categories, found.Authors, found.Categories)
+		}
+		if found.Validators() != entry.Validators() {
+			t.Errorf("expected validators %+v, got %+v", entry.Validators(), found.Validators())
+		}
+		if !found.FetchedAt.Equal(entry.FetchedAt) || !found.CheckedAt.Equal(entry.FetchedAt) || !found.UpdatedAt.Equal(entry.UpdatedAt) {
+			t.Errorf("timestamp mismatch: fetched %v checked %v updated %v", found.FetchedAt, found.CheckedAt, found.UpdatedAt)
+		}
+		if found.TTL != entry.TTL {
+			t.Errorf("expected ttl %v, got %v", entry.TTL, found.TTL)
+		}
+
+		if len(found.Items) != len(entry.Items) {
+			t.Fatalf("expected %d items, got %d", len(entry.Items), len(found.Items))
+		}
+		newest := found.Items[0]
+		if newest.Title != "Second" {
+			t.Fatalf("expected items newest first, got %q first", newest.Title)
+		}
+		want := entry.Items[1]
+		if newest.ID != want.ID || newest.FeedURL != "https://example.com/rss.xml" || newest.GUID != want.GUID ||
+			newest.Link != want.Link || newest.Content != want.Content || newest.Image != want.Image {
+			t.Errorf("item mismatch: %+v", newest)
+		}
+		if !reflect.DeepEqual(newest.Authors, want.Authors) || !reflect.DeepEqual(newest.Enclosures, want.Enclosures) {
+			t.Errorf("expected authors %v and enclosures %v, got %v and %v", want.Authors, want.Enclosures, newest.Authors, newest.Enclosures)
+		}
+		if !newest.PublishedAt.Equal(want.PublishedAt) || !newest.UpdatedAt.Equal(want.UpdatedAt) {
+			t.Errorf("item timestamp mismatch: published %v updated %v", newest.PublishedAt, newest.UpdatedAt)
+		}
+		if newest.Read || newest.Starred || newest.Archived {
+			t.Errorf("expected new item to be unread, got %+v", newest)
+		}
+	})
+
+	t.Run("SaveUpsertsAndMergesItems", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+
+		first := sampleFeed("https://example.com/rss.xml", baseTime)
+		if err := store.Save(ctx, first); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+
+		second := sampleFeed("https://example.com/rss.xml", baseTime.Add(time.Hour))
+		second.Title = "Renamed"
+		second.Items = []feed.Item{
+			{GUID: "second", Title: "Second (edited)", Link: "https://example.com/2", PublishedAt: baseTime.Add(-time.Hour)},
+			{GUID: "third", Title: "Third", Link: "https://example.com/3", PublishedAt: baseTime},
+		}
+		if err := store.Save(ctx, second); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+		if second.Items[0].ID != first.Items[1].ID {
+			t.Errorf("expected re-saved item to keep id %d, got %d", first.Items[1].ID, second.Items[0].ID)
+		}
+
+		found, err := store.FindByURL(ctx, "https://example.com/rss.xml")
+		if err != nil {
+			t.Fatalf("find: %v", err)
+		}
+		if found.Title != "Renamed" || !found.FetchedAt.Equal(second.FetchedAt) {
+			t.Errorf("expected metadata to be replaced, got %q fetched %v", found.Title, found.FetchedAt)
+		}
+
+		var titles []string
+		for _, item := range found.Items {
+			titles = append(titles, item.Title)
+		}
+		if want := []string{"Third", "Second (edited)", "First"}; !reflect.DeepEqual(titles, want) {
+			t.Errorf("expected merged history %v, got %v", want, titles)
+		}
+
+		recent, err := store.ListRecent(ctx, feed.RecentQuery{Limit: 10})
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		if len(recent) != 1 {
+			t.Fatalf("expected a single stored feed after upsert, got %d", len(recent))
+		}
+	})
+
+	t.Run("ListRecentOrdersByFetchedAt", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+
+		for i, url := range []string{"https://a.example.com/rss", "https://b.example.com/rss", "https://c.example.com/rss"} {
+			entry := sampleFeed(url, baseTime.Add(time.Duration(i)*time.Minute))
+			if err := store.Save(ctx, entry); err != nil {
+				t.Fatalf("save %s: %v", url, err)
+			}
+		}
+
+		recent, err := store.ListRecent(ctx, feed.RecentQuery{Limit: 2})
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		if len(recent) != 2 {
+			t.Fatalf("expected limit to be honoured, got %d feeds", len(recent))
+		}
+		if recent[0].SourceURL != "https://c.example.com/rss" || recent[1].SourceURL != "https://b.example.com/rss" {
+			t.Errorf("expected newest first, got %s then %s", recent[0].SourceURL, recent[1].SourceURL)
+		}
+		if recent[0].Title != "Example" || !recent[0].FetchedAt.Equal(baseTime.Add(2*time.Minute)) {
+			t.Errorf("summary mismatch: %+v", recent[0])
+		}
+		if recent[0].UnreadCount != 2 {
+			t.Errorf("expected 2 unread items, got %d", recent[0].UnreadCount)
+		}
+
+		all, err := store.ListRecent(ctx, feed.RecentQuery{Limit: 0})
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		if len(all) != 3 {
+			t.Errorf("expected a default limit to list every feed, got %d", len(all))
+		}
+	})
+
+	t.Run("ListRecentPagesEachSort", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+
+		// Equal fetch times and unread counts exercise the id tie-breaker.
+		titles := []string{"banana", "Cereja", "abacaxi", "Damasco", "amora"}
+		for i, title := range titles {
+			entry := sampleFeed(fmt.Sprintf("https://%d.example.com/rss", i), baseTime.Add(time.Duration(i/2)*time.Minute))
+			entry.Title = title
+			entry.Items = entry.Items[:i%3]
+			if err := store.Save(ctx, entry); err != nil {
+				t.Fatalf("save %s: %v", title, err)
+			}
+		}
+
+		cases := map[string][]string{
+			feed.SortFetchedAt: {"amora", "Damasco", "abacaxi", "Cereja", "banana"},
+			feed.SortTitle:     {"abacaxi", "amora", "banana", "Cereja", "Damasco"},
+			feed.SortUnread:    {"abacaxi", "amora", "Cereja", "Damasco", "banana"},
+		}
+		for sort, want := range cases {
+			var (
+				got   []string
+				after feed.SummaryCursor
+			)
+			for pages := 0; pages <= len(titles); pages++ {
+				page, err := store.ListRecent(ctx, feed.RecentQuery{Sort: sort, After: after, Limit: 2})
+				if err != nil {
+					t.Fatalf("sort %s: list: %v", sort, err)
+				}
+				if len(page) == 0 {
+					break
+				}
+				for _, summary := range page {
+					if summary.ID == 0 {
+						t.Fatalf("sort %s: expected summaries to carry their id", sort)
+					}
+					got = append(got, summary.Title)
+				}
+				after = feed.SummaryCursorFor(sort, page[len(page)-1])
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("sort %s: expected %v, got %v", sort, want, got)
+			}
+		}
+	})
+
+	t.Run("ListRecentSortsAccentedTitles", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+
+		// Accents and case are ignored, so "Ética" sorts with the other e's.
+		titles := []string{"zebra", "Órgão", "Ética", "onda", "estrela", "ÉPOCA"}
+		for i, title := range titles {
+			entry := sampleFeed(fmt.Sprintf("https://%d.example.com/rss", i), baseTime)
+			entry.Title = title
+			if err := store.Save(ctx, entry); err != nil {
+				t.Fatalf("save %s: %v", title, err)
+			}
+		}
+
+		var (
+			got   []string
+			after feed.SummaryCursor
+		)
+		for pages := 0; pages <= len(titles); pages++ {
+			page, err := store.ListRecent(ctx, feed.RecentQuery{Sort: feed.SortTitle, After: after, Limit: 2})
+			if err != nil {
+				t.Fatalf("list: %v", err)
+			}
+			if len(page) == 0 {
+				break
+			}
+			for _, summary := range page {
+				got = append(got, summary.Title)
+			}
+			after = feed.SummaryCursorFor(feed.SortTitle, page[len(page)-1])
+		}
+		if want := []string{"ÉPOCA", "estrela", "Ética", "onda", "Órgão", "zebra"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("MarkChecked", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+
+		entry := sampleFeed("https://example.com/rss.xml", baseTime)
+		if err := store.Save(ctx, entry); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+
+		checkedAt := baseTime.Add(30 * time.Minute)
+		if err := store.MarkChecked(ctx, " https://example.com/rss.xml", checkedAt, time.Hour); err != nil {
+			t.Fatalf("mark checked: %v", err)
+		}
+
+		found, err := store.FindByURL(ctx, "https://example.com/rss.xml")
+		if err != nil {
+			t.Fatalf("find: %v", err)
+		}
+		if !found.CheckedAt.Equal(checkedAt) || !found.FetchedAt.Equal(baseTime) {
+			t.Errorf("expected checked %v and fetched %v, got %v and %v", checkedAt, baseTime, found.CheckedAt, found.FetchedAt)
+		}
+		if found.TTL != time.Hour {
+			t.Errorf("expected ttl to be renewed, got %v", found.TTL)
+		}
+		if len(found.Items) != len(entry.Items) {
+			t.Errorf("expected items to be untouched, got %d", len(found.Items))
+		}
+
+		if err := store.MarkChecked(ctx, "https://example.com/unknown.xml", checkedAt, time.Hour); err != nil {
+			t.Errorf("expected unknown feeds to be ignored, got %v", err)
+		}
+	})
+
+	t.Run("FindByURLReturnsDetachedCopy", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+
+		if err := store.Save(ctx, sampleFeed("https://example.com/rss.xml", baseTime)); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+
+		found, err := store.FindByURL(ctx, "https://example.com/rss.xml")
+		if err != nil {
+			t.Fatalf("find: %v", err)
+		}
+		found.Title = "Changed"
+		found.Authors[0] = "Changed"
+		found.Items[0].Title = "Changed"
+
+		again, err := store.FindByURL(ctx, "https://example.com/rss.xml")
+		if err != nil {
+			t.Fatalf("find: %v", err)
+		}
+		if again.Title != "Example" || again.Authors[0] != "Ana" || again.Items[0].Title != "Second" {
+			t.Errorf("expected stored feed to be unaffected by caller mutations, got %+v", again)
+		}
+	})
+
+	t.Run("ConcurrentSaves", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+
+		const writers = 8
+		var wg sync.WaitGroup
+		errs := make(chan error, writers*2)
+		for i := 0; i < writers; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				url := fmt.Sprintf("https://example.com/%d.xml", i)
+				if err := store.Save(ctx, sampleFeed(url, baseTime.Add(time.Duration(i)*time.Second))); err != nil {
+					errs <- err
+					return
+				}
+				if _, err := store.ListRecent(ctx, feed.RecentQuery{Limit: writers}); err != nil {
+					errs <- err
+				}
+			}(i)
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			t.Fatalf("concurrent access failed: %v", err)
+		}
+
+		recent, err := store.ListRecent(ctx, feed.RecentQuery{Limit: writers})
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		if len(recent) != writers {
+			t.Fatalf("expected %d feeds, got %d", writers, len(recent))
+		}
+		for i := 1; i < len(recent); i++ {
+			if recent[i].FetchedAt.After(recent[i-1].FetchedAt) {
+				t.Fatalf("expected feeds ordered by fetched at, got %v before %v", recent[i-1].FetchedAt, recent[i].FetchedAt)
+			}
+		}
+	})
+
+	t.Run("LookupAndDelete", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+
+		for _, url := range []string{"https://a.example.com/rss", "https://b.example.com/rss"} {
+			if err := store.Save(ctx, sampleFeed(url, baseTime)); err != nil {
+				t.Fatalf("save %s: %v", url, err)
+			}
+		}
+		recent, err := store.ListRecent(ctx, feed.RecentQuery{Sort: feed.SortTitle})
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		for _, summary := range recent {
+			url, err := store.LookupURL(ctx, summary.ID)
+			if err != nil {
+				t.Fatalf("lookup: %v", err)
+			}
+			if url != summary.SourceURL {
+				t.Errorf("expected id %d to resolve to %s, got %q", summary.ID, summary.SourceURL, url)
+			}
+		}
+		if url, err := store.LookupURL(ctx, 9999); err != nil || url != "" {
+			t.Errorf("expected unknown id to resolve to nothing, got %q, %v", url, err)
+		}
+
+		deleted, err := store.Delete(ctx, " https://a.example.com/rss")
+		if err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		if !deleted {
+			t.Error("expected stored feed to be reported as deleted")
+		}
+		if deleted, err := store.Delete(ctx, "https://a.example.com/rss"); err != nil || deleted {
+			t.Errorf("expected second delete to find nothing, got %v, %v", deleted, err)
+		}
+
+		found, err := store.FindByURL(ctx, "https://a.example.com/rss")
+		if err != nil {
+			t.Fatalf("find: %v", err)
+		}
+		if found != nil {
+			t.Errorf("expected deleted feed to be gone, got %+v", found)
+		}
+		other, err := store.FindByURL(ctx, "https://b.example.com/rss")
+		if err != nil {
+			t.Fatalf("find: %v", err)
+		}
+		if other == nil || len(other.Items) != 2 {
+			t.Fatalf("expected the other feed to keep its items, got %+v", other)
+		}
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+
+		if err := store.Save(ctx, sampleFeed("https://example.com/rss.xml", baseTime)); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+		if err := store.Clear(ctx); err != nil {
+			t.Fatalf("clear: %v", err)
+		}
+
+		recent, err := store.ListRecent(ctx, feed.RecentQuery{Limit: 10})
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		if len(recent) != 0 {
+			t.Errorf("expected no feeds after clear, got %d", len(recent))
+		}
+		found, err := store.FindByURL(ctx, "https://example.com/rss.xml")
+		if err != nil {
+			t.Fatalf("find: %v", err)
+		}
+		if found != nil {
+			t.Errorf("expected cleared feed to be gone, got %+v", found)
+		}
+	})
+}
+
+// baseTime is truncated to whole seconds so every backend can store it losslessly.
+var baseTime = time.Date(2024, time.May, 10, 12, 0, 0, 0, time.UTC)
+
+func sampleFeed(sourceURL string, fetchedAt time.Time) *feed.Feed {
+	return &feed.Feed{
+		SourceURL:    sourceURL,
+		Title:        "Example",
+		Description:  "Example feed",
+		Link:         "https://example.com",
+		Image:        "https://example.com/logo.png",
+		Authors:      []string{"Ana"},
+		Categories:   []string{"tech", "news"},
+		UpdatedAt:    baseTime.Add(-time.Minute),
+		FetchedAt:    fetchedAt,
+		ETag:         `"v1"`,
+		LastModified: "Fri, 10 May 2024 11:59:00 GMT",
+		TTL:          15 * time.Minute,
+		Items: []feed.Item{
+			{
+				GUID:        "first",
+				Title:       "First",
+				Link:        "https://example.com/1",
+				Description: "First item",
+				PublishedAt: baseTime.Add(-2 * time.Hour),
+			},
+			{
+				GUID:        "second",
+				Title:       "Second",
+				Link:        "https://example.com/2",
+				Description: "Second item",
+				Content:     "<p>Second item</p>",
+				Authors:     []string{"Bia"},
+				Categories:  []string{"go"},
+				Image:       "https://example.com/2.png",
+				Enclosures:  []feed.Enclosure{{URL: "https://example.com/2.mp3", Type: "audio/mpeg", Length: 1024}},
+				PublishedAt: baseTime.Add(-time.Hour),
+				UpdatedAt:   baseTime.Add(-30 * time.Minute),
+			},
+		},
+	}
+}
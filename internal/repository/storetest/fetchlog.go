@@ -0,0 +1,119 @@
+package storetest
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// TestFetchLogStore runs the FetchLogStore conformance suite. newStore must return an
+// empty store each time it is called.
+func TestFetchLogStore(t *testing.T, newStore func(t *testing.T) repository.FetchLogStore) {
+	t.Helper()
+
+	base := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	attempt := func(url string, minute int, errorClass string) feed.FetchAttempt {
+		return feed.FetchAttempt{
+			SourceURL:   url,
+			AttemptedAt: base.Add(time.Duration(minute) * time.Minute),
+			StatusCode:  200,
+			Duration:    1500 * time.Millisecond,
+			Bytes:       2048,
+			ErrorClass:  errorClass,
+			ItemDelta:   minute,
+		}
+	}
+
+	t.Run("HistoryNewestFirst", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+
+		failed := attempt("https://a.example/rss", 2, feed.FetchErrorStatus)
+		failed.StatusCode, failed.Bytes, failed.Error = 503, 0, "upstream returned status 503"
+		for _, entry := range []feed.FetchAttempt{
+			attempt("https://a.example/rss", 0, ""),
+			attempt("https://b.example/rss", 0, ""),
+			attempt("https://a.example/rss", 1, ""),
+			failed,
+		} {
+			if err := store.RecordFetch(ctx, entry); err != nil {
+				t.Fatalf("record fetch: %v", err)
+			}
+		}
+
+		history, err := store.FetchHistory(ctx, []string{"https://a.example/rss", "https://b.example/rss", "https://c.example/rss"}, 2)
+		if err != nil {
+			t.Fatalf("fetch history: %v", err)
+		}
+		if len(history) != 2 || len(history["https://a.example/rss"]) != 2 || len(history["https://b.example/rss"]) != 1 {
+			t.Fatalf("unexpected history %+v", history)
+		}
+
+		got := history["https://a.example/rss"][0]
+		if got.ID == 0 || !got.AttemptedAt.Equal(failed.AttemptedAt) {
+			t.Fatalf("expected the latest attempt first, got %+v", got)
+		}
+		got.ID, got.AttemptedAt = 0, failed.AttemptedAt
+		if !reflect.DeepEqual(got, failed) {
+			t.Fatalf("expected %+v, got %+v", failed, got)
+		}
+		if older := history["https://a.example/rss"][1]; older.ItemDelta != 1 || older.Failed() {
+			t.Fatalf("unexpected older attempt %+v", older)
+		}
+	})
+
+	t.Run("KeepsLatestAttempts", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		for i := 0; i < feed.FetchLogRetention+5; i++ {
+			if err := store.RecordFetch(ctx, attempt("https://a.example/rss", i, "")); err != nil {
+				t.Fatalf("record fetch: %v", err)
+			}
+		}
+
+		history, err := store.FetchHistory(ctx, []string{"https://a.example/rss"}, feed.FetchLogRetention*2)
+		if err != nil {
+			t.Fatalf("fetch history: %v", err)
+		}
+		attempts := history["https://a.example/rss"]
+		if len(attempts) != feed.FetchLogRetention {
+			t.Fatalf("expected %d attempts to be kept, got %d", feed.FetchLogRetention, len(attempts))
+		}
+		if first, last := attempts[0].ItemDelta, attempts[len(attempts)-1].ItemDelta; first != feed.FetchLogRetention+4 || last != 5 {
+			t.Fatalf("expected the oldest attempts to be discarded, kept %d..%d", last, first)
+		}
+	})
+
+	t.Run("DeleteAndClearHistory", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		urls := []string{"https://a.example/rss", "https://b.example/rss", "https://c.example/rss"}
+		for _, url := range urls {
+			if err := store.RecordFetch(ctx, attempt(url, 0, "")); err != nil {
+				t.Fatalf("record fetch: %v", err)
+			}
+		}
+
+		if err := store.DeleteFetchHistory(ctx, urls[0]); err != nil {
+			t.Fatalf("delete fetch history: %v", err)
+		}
+		history, err := store.FetchHistory(ctx, urls, 10)
+		if err != nil {
+			t.Fatalf("fetch history: %v", err)
+		}
+		if _, ok := history[urls[0]]; ok || len(history) != 2 {
+			t.Fatalf("expected only the other URLs to keep their history, got %+v", history)
+		}
+
+		if err := store.ClearFetchHistory(ctx); err != nil {
+			t.Fatalf("clear fetch history: %v", err)
+		}
+		if history, err := store.FetchHistory(ctx, urls, 10); err != nil || len(history) != 0 {
+			t.Fatalf("expected no history left, got %+v, %v", history, err)
+		}
+	})
+}
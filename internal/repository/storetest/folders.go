@@ -0,0 +1,226 @@
+package storetest
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// FolderBackend is a store able to organize feeds into folders and tags.
+type FolderBackend interface {
+	repository.FeedStore
+	repository.ItemStateStore
+	repository.FolderStore
+	repository.TagStore
+}
+
+// TestFolderStore runs the FolderStore and TagStore conformance suite. newStore must
+// return a store without feeds or folders each time it is called.
+func TestFolderStore(t *testing.T, newStore func(t *testing.T) FolderBackend) {
+	t.Helper()
+
+	// seed stores two feeds and returns their ids.
+	seed := func(t *testing.T, store FolderBackend) (a, b int64) {
+		t.Helper()
+		ctx := context.Background()
+		for _, entry := range []*feed.Feed{
+			{SourceURL: "https://a.example/rss", Title: "A", FetchedAt: baseTime, Items: []feed.Item{{GUID: "a1"}, {GUID: "a2"}}},
+			{SourceURL: "https://b.example/rss", Title: "B", FetchedAt: baseTime.Add(-1), Items: []feed.Item{{GUID: "b1"}}},
+		} {
+			if err := store.Save(ctx, entry); err != nil {
+				t.Fatalf("save: %v", err)
+			}
+		}
+		summaries, err := store.ListRecent(ctx, feed.RecentQuery{})
+		if err != nil || len(summaries) != 2 {
+			t.Fatalf("list: %v %+v", err, summaries)
+		}
+		return summaries[0].ID, summaries[1].ID
+	}
+
+	createFolder := func(t *testing.T, store FolderBackend, name string) *feed.Folder {
+		t.Helper()
+		folder, err := store.CreateFolder(context.Background(), name)
+		if err != nil {
+			t.Fatalf("create folder: %v", err)
+		}
+		if folder.ID == 0 || folder.Name != name || folder.CreatedAt.IsZero() {
+			t.Fatalf("unexpected folder %+v", folder)
+		}
+		return folder
+	}
+
+	t.Run("FolderNamesAreUnique", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		news := createFolder(t, store, "News")
+		tech := createFolder(t, store, "Tech")
+
+		if _, err := store.CreateFolder(ctx, "news"); !errors.Is(err, feed.ErrConflict) {
+			t.Fatalf("expected conflict for a duplicate name, got %v", err)
+		}
+		if _, err := store.RenameFolder(ctx, tech.ID, "NEWS"); !errors.Is(err, feed.ErrConflict) {
+			t.Fatalf("expected conflict when renaming onto a taken name, got %v", err)
+		}
+
+		renamed, err := store.RenameFolder(ctx, news.ID, "Notícias")
+		if err != nil {
+			t.Fatalf("rename: %v", err)
+		}
+		if renamed == nil || renamed.ID != news.ID || renamed.Name != "Notícias" {
+			t.Fatalf("unexpected renamed folder %+v", renamed)
+		}
+		if missing, err := store.RenameFolder(ctx, news.ID+tech.ID+100, "Other"); err != nil || missing != nil {
+			t.Fatalf("expected nil for an unknown folder, got %+v, %v", missing, err)
+		}
+
+		folders, err := store.ListFolders(ctx)
+		if err != nil {
+			t.Fatalf("list folders: %v", err)
+		}
+		if len(folders) != 2 || folders[0].Name != "Notícias" || folders[1].Name != "Tech" {
+			t.Fatalf("expected folders ordered by name, got %+v", folders)
+		}
+	})
+
+	t.Run("MoveFeedsAndCountUnread", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		a, b := seed(t, store)
+		folder := createFolder(t, store, "Tech")
+
+		for _, id := range []int64{a, b} {
+			moved, err := store.MoveFeed(ctx, id, folder.ID)
+			if err != nil || !moved {
+				t.Fatalf("move feed %d: %v %v", id, moved, err)
+			}
+		}
+		if moved, err := store.MoveFeed(ctx, a, folder.ID+100); err != nil || moved {
+			t.Fatalf("expected moving into an unknown folder to fail, got %v %v", moved, err)
+		}
+		if moved, err := store.MoveFeed(ctx, a+b+100, folder.ID); err != nil || moved {
+			t.Fatalf("expected moving an unknown feed to fail, got %v %v", moved, err)
+		}
+
+		found, err := store.FindByURL(ctx, "https://a.example/rss")
+		if err != nil {
+			t.Fatalf("find: %v", err)
+		}
+		read := true
+		if _, err := store.UpdateItemState(ctx, found.Items[0].ID, feed.StateChange{Read: &read}); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+
+		folders, err := store.ListFolders(ctx)
+		if err != nil {
+			t.Fatalf("list folders: %v", err)
+		}
+		if len(folders) != 1 || folders[0].FeedCount != 2 || folders[0].UnreadCount != 2 {
+			t.Fatalf("expected 2 feeds with 2 unread items, got %+v", folders)
+		}
+
+		if moved, err := store.MoveFeed(ctx, b, 0); err != nil || !moved {
+			t.Fatalf("unfile feed: %v %v", moved, err)
+		}
+		urls, err := store.FolderFeedURLs(ctx, folder.ID)
+		if err != nil {
+			t.Fatalf("folder feeds: %v", err)
+		}
+		if !reflect.DeepEqual(urls, []string{"https://a.example/rss"}) {
+			t.Fatalf("expected only feed A in the folder, got %v", urls)
+		}
+
+		scoped, err := store.ListRecent(ctx, feed.RecentQuery{FolderID: folder.ID})
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		if len(scoped) != 1 || scoped[0].ID != a || scoped[0].FolderID != folder.ID || scoped[0].UnreadCount != 1 {
+			t.Fatalf("expected feed A filed in the folder, got %+v", scoped)
+		}
+	})
+
+	t.Run("DeleteFolderUnfilesFeeds", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		a, _ := seed(t, store)
+		folder := createFolder(t, store, "Tech")
+		if _, err := store.MoveFeed(ctx, a, folder.ID); err != nil {
+			t.Fatalf("move: %v", err)
+		}
+
+		deleted, err := store.DeleteFolder(ctx, folder.ID)
+		if err != nil || !deleted {
+			t.Fatalf("delete folder: %v %v", deleted, err)
+		}
+		if deleted, err := store.DeleteFolder(ctx, folder.ID); err != nil || deleted {
+			t.Fatalf("expected second delete to report a missing folder, got %v %v", deleted, err)
+		}
+		if urls, err := store.FolderFeedURLs(ctx, folder.ID); err != nil || urls != nil {
+			t.Fatalf("expected nil urls for a deleted folder, got %v %v", urls, err)
+		}
+
+		summaries, err := store.ListRecent(ctx, feed.RecentQuery{})
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		if len(summaries) != 2 || summaries[0].FolderID != 0 {
+			t.Fatalf("expected feeds to survive unfiled, got %+v", summaries)
+		}
+	})
+
+	t.Run("TagFeeds", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		a, b := seed(t, store)
+
+		if ok, err := store.SetFeedTags(ctx, a, []string{"go", "news"}); err != nil || !ok {
+			t.Fatalf("tag feed A: %v %v", ok, err)
+		}
+		if ok, err := store.SetFeedTags(ctx, b, []string{"go"}); err != nil || !ok {
+			t.Fatalf("tag feed B: %v %v", ok, err)
+		}
+		if ok, err := store.SetFeedTags(ctx, a+b+100, []string{"go"}); err != nil || ok {
+			t.Fatalf("expected tagging an unknown feed to fail, got %v %v", ok, err)
+		}
+
+		tags, err := store.ListTags(ctx)
+		if err != nil {
+			t.Fatalf("list tags: %v", err)
+		}
+		want := []feed.TagCount{{Tag: "go", FeedCount: 2}, {Tag: "news", FeedCount: 1}}
+		if !reflect.DeepEqual(tags, want) {
+			t.Fatalf("expected %+v, got %+v", want, tags)
+		}
+
+		urls, err := store.TagFeedURLs(ctx, "news")
+		if err != nil {
+			t.Fatalf("tag feeds: %v", err)
+		}
+		if !reflect.DeepEqual(urls, []string{"https://a.example/rss"}) {
+			t.Fatalf("expected only feed A tagged news, got %v", urls)
+		}
+
+		// Replacing the tags drops the ones left out.
+		if _, err := store.SetFeedTags(ctx, a, []string{"tech"}); err != nil {
+			t.Fatalf("retag: %v", err)
+		}
+		summaries, err := store.ListRecent(ctx, feed.RecentQuery{Tag: "go"})
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		if len(summaries) != 1 || summaries[0].ID != b || !reflect.DeepEqual(summaries[0].Tags, []string{"go"}) {
+			t.Fatalf("expected only feed B tagged go, got %+v", summaries)
+		}
+
+		if _, err := store.Delete(ctx, "https://b.example/rss"); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		if urls, err := store.TagFeedURLs(ctx, "go"); err != nil || len(urls) != 0 {
+			t.Fatalf("expected tags to go away with their feed, got %v %v", urls, err)
+		}
+	})
+}
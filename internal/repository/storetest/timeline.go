@@ -0,0 +1,152 @@
+package storetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// TimelineBackend is a store able to back the merged timeline.
+type TimelineBackend interface {
+	repository.FeedStore
+	repository.ItemStateStore
+	repository.TimelineStore
+}
+
+// TestTimelineStore runs the TimelineStore conformance suite. newStore must return an
+// empty store each time it is called.
+func TestTimelineStore(t *testing.T, newStore func(t *testing.T) TimelineBackend) {
+	t.Helper()
+
+	seed := func(t *testing.T, store TimelineBackend) (a, b *feed.Feed) {
+		t.Helper()
+		a = &feed.Feed{SourceURL: "https://a.example/rss", Title: "A", FetchedAt: baseTime, Items: []feed.Item{
+			{GUID: "a1", Title: "A1", Link: "https://a.example/1", PublishedAt: baseTime.Add(-3 * time.Hour)},
+			{GUID: "a2", Title: "A2", Link: "https://shared.example/story", PublishedAt: baseTime.Add(-time.Hour)},
+			{GUID: "a3", Title: "A3", PublishedAt: baseTime},
+		}}
+		b = &feed.Feed{SourceURL: "https://b.example/rss", Title: "B", FetchedAt: baseTime, Items: []feed.Item{
+			{GUID: "b1", Title: "B1", Link: "https://b.example/1", PublishedAt: baseTime.Add(-2 * time.Hour)},
+			{GUID: "b2", Title: "B2", Link: "https://shared.example/story", PublishedAt: baseTime.Add(-30 * time.Minute)},
+		}}
+		for _, entry := range []*feed.Feed{a, b} {
+			if err := store.Save(context.Background(), entry); err != nil {
+				t.Fatalf("save: %v", err)
+			}
+		}
+		return a, b
+	}
+
+	titles := func(items []feed.Item) []string {
+		result := make([]string, 0, len(items))
+		for _, item := range items {
+			result = append(result, item.Title)
+		}
+		return result
+	}
+
+	t.Run("MergesNewestFirstWithoutDuplicateLinks", func(t *testing.T) {
+		store := newStore(t)
+		seed(t, store)
+
+		items, err := store.Timeline(context.Background(), feed.TimelineQuery{Limit: 10})
+		if err != nil {
+			t.Fatalf("timeline: %v", err)
+		}
+		assertTitles(t, titles(items), "A3", "A2", "B1", "A1")
+		if items[1].FeedURL != "https://a.example/rss" {
+			t.Errorf("expected feed url to be filled, got %q", items[1].FeedURL)
+		}
+	})
+
+	t.Run("FiltersByFeed", func(t *testing.T) {
+		store := newStore(t)
+		seed(t, store)
+
+		items, err := store.Timeline(context.Background(), feed.TimelineQuery{FeedURLs: []string{"https://b.example/rss"}, Limit: 10})
+		if err != nil {
+			t.Fatalf("timeline: %v", err)
+		}
+		assertTitles(t, titles(items), "B2", "B1")
+
+		none, err := store.Timeline(context.Background(), feed.TimelineQuery{FeedURLs: []string{}, Limit: 10})
+		if err != nil {
+			t.Fatalf("timeline: %v", err)
+		}
+		if len(none) != 0 {
+			t.Fatalf("expected an empty feed list to match nothing, got %v", titles(none))
+		}
+	})
+
+	t.Run("FiltersByStateAndDate", func(t *testing.T) {
+		store := newStore(t)
+		a, _ := seed(t, store)
+		ctx := context.Background()
+
+		read, starred := true, true
+		if _, err := store.UpdateItemState(ctx, a.Items[1].ID, feed.StateChange{Read: &read, Starred: &starred}); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+
+		unread := false
+		items, err := store.Timeline(ctx, feed.TimelineQuery{Read: &unread, Limit: 10})
+		if err != nil {
+			t.Fatalf("timeline: %v", err)
+		}
+		// With A2 read, its duplicate from feed B is the first unread copy of the link.
+		assertTitles(t, titles(items), "A3", "B2", "B1", "A1")
+
+		items, err = store.Timeline(ctx, feed.TimelineQuery{Starred: &starred, Limit: 10})
+		if err != nil {
+			t.Fatalf("timeline: %v", err)
+		}
+		assertTitles(t, titles(items), "A2")
+
+		items, err = store.Timeline(ctx, feed.TimelineQuery{From: baseTime.Add(-2 * time.Hour), To: baseTime, Limit: 10})
+		if err != nil {
+			t.Fatalf("timeline: %v", err)
+		}
+		assertTitles(t, titles(items), "A2", "B1")
+	})
+
+	t.Run("CursorIsStableUnderInserts", func(t *testing.T) {
+		store := newStore(t)
+		seed(t, store)
+		ctx := context.Background()
+
+		first, err := store.Timeline(ctx, feed.TimelineQuery{Limit: 2})
+		if err != nil {
+			t.Fatalf("timeline: %v", err)
+		}
+		assertTitles(t, titles(first), "A3", "A2")
+
+		newer := &feed.Feed{SourceURL: "https://c.example/rss", Title: "C", FetchedAt: baseTime, Items: []feed.Item{
+			{GUID: "c1", Title: "C1", Link: "https://c.example/1", PublishedAt: baseTime.Add(time.Hour)},
+		}}
+		if err := store.Save(ctx, newer); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+
+		last := first[len(first)-1]
+		rest, err := store.Timeline(ctx, feed.TimelineQuery{After: feed.Cursor{Time: last.PublishedAt, ID: last.ID}, Limit: 10})
+		if err != nil {
+			t.Fatalf("timeline: %v", err)
+		}
+		assertTitles(t, titles(rest), "B1", "A1")
+	})
+}
+
+func assertTitles(t *testing.T, got []string, want ...string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+}
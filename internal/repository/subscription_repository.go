@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+// SubscriptionStore persists the feeds that should be polled periodically.
+type SubscriptionStore interface {
+	// Subscribe creates or updates the subscription for the entry source URL,
+	// filling in the generated fields.
+	Subscribe(ctx context.Context, entry *feed.Subscription) error
+	// Unsubscribe removes the subscription for the given URL, if any.
+	Unsubscribe(ctx context.Context, url string) error
+	// List returns every subscription ordered by source URL.
+	List(ctx context.Context) ([]feed.Subscription, error)
+	// ListDue returns subscriptions whose next poll is scheduled at or before now.
+	ListDue(ctx context.Context, now time.Time, limit int) ([]feed.Subscription, error)
+	// MarkPolled records the outcome of a poll and schedules the next one.
+	MarkPolled(ctx context.Context, id int64, polledAt time.Time, pollErr error) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"rssreader/internal/domain/feed"
+)
+
+// TimelineStore merges stored items from every feed into a single listing.
+type TimelineStore interface {
+	// Timeline returns up to query.Limit items matching the query, ordered by
+	// published at and id descending.
+	Timeline(ctx context.Context, query feed.TimelineQuery) ([]feed.Item, error)
+}
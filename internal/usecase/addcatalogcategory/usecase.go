@@ -0,0 +1,41 @@
+package addcatalogcategory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// UseCase adds categories to the curated catalog.
+type UseCase struct {
+	store repository.CatalogStore
+}
+
+// New constructs the use case with its dependencies.
+func New(store repository.CatalogStore) *UseCase {
+	return &UseCase{store: store}
+}
+
+// Execute stores a new, empty category after the existing ones.
+func (uc *UseCase) Execute(ctx context.Context, category feed.CatalogCategory) (*feed.CatalogCategory, error) {
+	if uc.store == nil {
+		return nil, errors.New("catalog store not configured")
+	}
+
+	category, err := feed.NormalizeCatalogCategory(category)
+	if err != nil {
+		return nil, err
+	}
+
+	added, err := uc.store.AddCatalogCategory(ctx, category)
+	if errors.Is(err, feed.ErrConflict) {
+		return nil, fmt.Errorf("catalog category %q %w", category.ID, feed.ErrConflict)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("add catalog category: %w: %w", feed.ErrStorage, err)
+	}
+	return added, nil
+}
@@ -0,0 +1,50 @@
+package addcatalogcategory_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+	feedRepo "rssreader/internal/infra/feed"
+	"rssreader/internal/usecase/addcatalogcategory"
+)
+
+func TestExecuteAddsCategory(t *testing.T) {
+	uc := addcatalogcategory.New(feedRepo.NewMemoryCatalogStore())
+
+	category, err := uc.Execute(context.Background(), feed.CatalogCategory{ID: " esportes ", Name: " Esportes "})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if category.ID != "esportes" || category.Name != "Esportes" {
+		t.Fatalf("expected trimmed fields, got %+v", category)
+	}
+
+	if _, err := uc.Execute(context.Background(), feed.CatalogCategory{ID: "esportes", Name: "Outra"}); !errors.Is(err, feed.ErrConflict) {
+		t.Fatalf("expected conflict for a taken id, got %v", err)
+	}
+}
+
+func TestExecuteValidatesCategory(t *testing.T) {
+	uc := addcatalogcategory.New(feedRepo.NewMemoryCatalogStore())
+
+	for name, category := range map[string]feed.CatalogCategory{
+		"missing id":  {Name: "Esportes"},
+		"not a slug":  {ID: "Esportes Radicais", Name: "Esportes"},
+		"long id":     {ID: strings.Repeat("a", feed.MaxCatalogIDLength+1), Name: "Esportes"},
+		"no name":     {ID: "esportes", Name: " "},
+		"description": {ID: "esportes", Name: "Esportes", Description: strings.Repeat("x", feed.MaxCatalogDescriptionLength+1)},
+	} {
+		if _, err := uc.Execute(context.Background(), category); !errors.Is(err, feed.ErrInvalidInput) {
+			t.Errorf("%s: expected invalid input, got %v", name, err)
+		}
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	if _, err := addcatalogcategory.New(nil).Execute(context.Background(), feed.CatalogCategory{ID: "a", Name: "A"}); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
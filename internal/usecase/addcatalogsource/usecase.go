@@ -0,0 +1,48 @@
+package addcatalogsource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// ErrCategoryNotFound is returned when the source names an unknown category.
+var ErrCategoryNotFound = fmt.Errorf("catalog category %w", feed.ErrNotFound)
+
+// UseCase adds sources to the curated catalog.
+type UseCase struct {
+	store repository.CatalogStore
+}
+
+// New constructs the use case with its dependencies.
+func New(store repository.CatalogStore) *UseCase {
+	return &UseCase{store: store}
+}
+
+// Execute stores a new source at the end of its category. The source is flagged as
+// broken only once the health checker has failed to read it.
+func (uc *UseCase) Execute(ctx context.Context, source feed.CatalogSource) (*feed.CatalogSource, error) {
+	if uc.store == nil {
+		return nil, errors.New("catalog store not configured")
+	}
+
+	source, err := feed.NormalizeCatalogSource(source)
+	if err != nil {
+		return nil, err
+	}
+
+	added, err := uc.store.AddCatalogSource(ctx, source)
+	if errors.Is(err, feed.ErrConflict) {
+		return nil, fmt.Errorf("catalog source %s in %q %w", source.URL, source.CategoryID, feed.ErrConflict)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("add catalog source: %w: %w", feed.ErrStorage, err)
+	}
+	if added == nil {
+		return nil, ErrCategoryNotFound
+	}
+	return added, nil
+}
@@ -0,0 +1,56 @@
+package addcatalogsource_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+	feedRepo "rssreader/internal/infra/feed"
+	"rssreader/internal/usecase/addcatalogsource"
+)
+
+func TestExecuteAddsSource(t *testing.T) {
+	ctx := context.Background()
+	store := feedRepo.NewMemoryCatalogStore()
+	if _, err := store.AddCatalogCategory(ctx, feed.CatalogCategory{ID: "esportes", Name: "Esportes"}); err != nil {
+		t.Fatalf("add category: %v", err)
+	}
+	uc := addcatalogsource.New(store)
+
+	source, err := uc.Execute(ctx, feed.CatalogSource{CategoryID: "esportes", Name: " GE ", URL: " https://ge.example/rss "})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if source.ID == 0 || source.Name != "GE" || source.URL != "https://ge.example/rss" || source.Broken() {
+		t.Fatalf("unexpected source %+v", source)
+	}
+
+	if _, err := uc.Execute(ctx, feed.CatalogSource{CategoryID: "esportes", Name: "GE", URL: "https://ge.example/rss"}); !errors.Is(err, feed.ErrConflict) {
+		t.Fatalf("expected conflict for a duplicate url, got %v", err)
+	}
+	if _, err := uc.Execute(ctx, feed.CatalogSource{CategoryID: "unknown", Name: "GE", URL: "https://ge.example/rss"}); !errors.Is(err, addcatalogsource.ErrCategoryNotFound) {
+		t.Fatalf("expected unknown category, got %v", err)
+	}
+}
+
+func TestExecuteValidatesSource(t *testing.T) {
+	uc := addcatalogsource.New(feedRepo.NewMemoryCatalogStore())
+
+	for name, source := range map[string]feed.CatalogSource{
+		"category": {Name: "GE", URL: "https://ge.example/rss"},
+		"name":     {CategoryID: "esportes", URL: "https://ge.example/rss"},
+		"url":      {CategoryID: "esportes", Name: "GE", URL: "ftp://ge.example/rss"},
+	} {
+		if _, err := uc.Execute(context.Background(), source); !errors.Is(err, feed.ErrInvalidInput) {
+			t.Errorf("%s: expected invalid input, got %v", name, err)
+		}
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	source := feed.CatalogSource{CategoryID: "esportes", Name: "GE", URL: "https://ge.example/rss"}
+	if _, err := addcatalogsource.New(nil).Execute(context.Background(), source); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
@@ -0,0 +1,136 @@
+package checkcatalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// Config tunes how the catalog URLs are checked.
+type Config struct {
+	// Workers bounds the number of URLs checked concurrently.
+	Workers int
+	// Timeout bounds a single check.
+	Timeout time.Duration
+}
+
+// Report summarizes a health-check run.
+type Report struct {
+	Checked int
+	Failed  int
+}
+
+// UseCase downloads every enabled catalog URL and records whether it still serves a
+// feed, so broken sources can be flagged to readers.
+type UseCase struct {
+	store   repository.CatalogStore
+	fetcher repository.FeedFetcher
+	cfg     Config
+	clock   func() time.Time
+}
+
+// New constructs the use case, applying defaults to zero config values.
+func New(store repository.CatalogStore, fetcher repository.FeedFetcher, cfg Config, clock func() time.Time) *UseCase {
+	if cfg.Workers <= 0 {
+		cfg.Workers = 4
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 30 * time.Second
+	}
+	if clock == nil {
+		clock = time.Now
+	}
+	return &UseCase{store: store, fetcher: fetcher, cfg: cfg, clock: clock}
+}
+
+// Execute checks each distinct enabled URL once. URLs whose host is rejected by an
+// open circuit breaker are skipped rather than counted as failures.
+func (uc *UseCase) Execute(ctx context.Context) (Report, error) {
+	if uc.store == nil || uc.fetcher == nil {
+		return Report{}, errors.New("catalog checker dependencies not configured")
+	}
+
+	urls, err := uc.store.CatalogURLs(ctx)
+	if err != nil {
+		return Report{}, fmt.Errorf("list catalog: %w: %w", feed.ErrStorage, err)
+	}
+
+	var (
+		mu     sync.Mutex
+		report Report
+		errs   []error
+		wg     sync.WaitGroup
+		jobs   = make(chan string)
+	)
+	for i := 0; i < uc.cfg.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for url := range jobs {
+				checkErr := uc.check(ctx, url)
+				if errors.Is(checkErr, feed.ErrUpstreamUnavailable) || ctx.Err() != nil {
+					continue
+				}
+
+				var message string
+				if checkErr != nil {
+					message = checkErr.Error()
+				}
+				recordErr := uc.store.RecordCatalogCheck(ctx, url, uc.clock(), message)
+
+				mu.Lock()
+				report.Checked++
+				if checkErr != nil {
+					report.Failed++
+				}
+				if recordErr != nil {
+					errs = append(errs, recordErr)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+dispatch:
+	for _, url := range urls {
+		select {
+		case jobs <- url:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return report, fmt.Errorf("record catalog check: %w: %w", feed.ErrStorage, errors.Join(errs...))
+	}
+	return report, ctx.Err()
+}
+
+// check downloads the URL and makes sure it parses as a feed.
+func (uc *UseCase) check(ctx context.Context, url string) error {
+	ctx, cancel := context.WithTimeout(ctx, uc.cfg.Timeout)
+	defer cancel()
+
+	res, err := uc.fetcher.Fetch(ctx, url, feed.Validators{})
+	if err != nil {
+		return err
+	}
+	if res.Body == nil {
+		return nil
+	}
+	defer res.Body.Close()
+
+	if _, err := gofeed.NewParser().Parse(res.Body); err != nil {
+		return fmt.Errorf("parse feed: %w: %w", feed.ErrUnparseableFeed, err)
+	}
+	return nil
+}
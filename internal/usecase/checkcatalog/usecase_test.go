@@ -0,0 +1,145 @@
+package checkcatalog_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	feedRepo "rssreader/internal/infra/feed"
+	"rssreader/internal/repository"
+	"rssreader/internal/usecase/checkcatalog"
+)
+
+const sampleFeed = `<?xml version="1.0"?><rss version="2.0"><channel><title>Example</title></channel></rss>`
+
+// fetcherStub serves a payload or an error per URL and counts the requests.
+type fetcherStub struct {
+	mu        sync.Mutex
+	responses map[string]any
+	calls     map[string]int
+}
+
+func (f *fetcherStub) Fetch(ctx context.Context, url string, validators feed.Validators) (*repository.FetchResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls[url]++
+
+	switch response := f.responses[url].(type) {
+	case error:
+		return nil, response
+	case string:
+		return &repository.FetchResult{Body: io.NopCloser(strings.NewReader(response))}, nil
+	}
+	return nil, fmt.Errorf("unexpected url %s", url)
+}
+
+func TestExecuteRecordsHealth(t *testing.T) {
+	ctx := context.Background()
+	store := feedRepo.NewMemoryCatalogStore()
+	err := store.SeedCatalog(ctx, []feed.CatalogCategory{
+		{ID: "noticias", Name: "Notícias", Sources: []feed.CatalogSource{
+			{Name: "Ok", URL: "https://ok.example/rss"},
+			{Name: "Gone", URL: "https://gone.example/rss"},
+			{Name: "Html", URL: "https://html.example/"},
+			{Name: "Open", URL: "https://open.example/rss"},
+		}},
+		{ID: "tecnologia", Name: "Tecnologia", Sources: []feed.CatalogSource{
+			{Name: "Ok", URL: "https://ok.example/rss"},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+	fetcher := &fetcherStub{
+		responses: map[string]any{
+			"https://ok.example/rss":   sampleFeed,
+			"https://gone.example/rss": fmt.Errorf("fetch feed: %w", &feed.UpstreamStatusError{StatusCode: 404}),
+			"https://html.example/":    "<html><body>not a feed</body></html>",
+			"https://open.example/rss": fmt.Errorf("fetch feed: %w", feed.ErrUpstreamUnavailable),
+		},
+		calls: make(map[string]int),
+	}
+	now := time.Date(2024, time.May, 10, 12, 0, 0, 0, time.UTC)
+	uc := checkcatalog.New(store, fetcher, checkcatalog.Config{Workers: 2}, func() time.Time { return now })
+
+	for run := 1; run <= feed.CatalogBrokenAfter; run++ {
+		report, err := uc.Execute(ctx)
+		if err != nil {
+			t.Fatalf("run %d: unexpected error: %v", run, err)
+		}
+		if report.Checked != 3 || report.Failed != 2 {
+			t.Fatalf("run %d: expected 3 checked and 2 failed, got %+v", run, report)
+		}
+	}
+	if calls := fetcher.calls["https://ok.example/rss"]; calls != feed.CatalogBrokenAfter {
+		t.Errorf("expected a shared url to be checked once per run, got %d requests", calls)
+	}
+
+	categories, err := store.ListCatalog(ctx, false)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	broken := make(map[string]bool)
+	for _, source := range categories[0].Sources {
+		broken[source.Name] = source.Broken()
+		if source.Name == "Open" && !source.CheckedAt.IsZero() {
+			t.Errorf("expected a source behind an open breaker to stay unchecked, got %+v", source)
+		}
+		if source.Name == "Ok" && !source.CheckedAt.Equal(now) {
+			t.Errorf("expected the check time to be recorded, got %+v", source)
+		}
+	}
+	want := map[string]bool{"Ok": false, "Gone": true, "Html": true, "Open": false}
+	for name, flagged := range want {
+		if broken[name] != flagged {
+			t.Errorf("%s: expected broken=%v, got %v", name, flagged, broken[name])
+		}
+	}
+}
+
+func TestExecuteSkipsDisabledSources(t *testing.T) {
+	ctx := context.Background()
+	store := feedRepo.NewMemoryCatalogStore()
+	err := store.SeedCatalog(ctx, []feed.CatalogCategory{
+		{ID: "noticias", Name: "Notícias", Sources: []feed.CatalogSource{{Name: "Off", URL: "https://off.example/rss"}}},
+	})
+	if err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+	if _, err := store.SetCatalogSourceDisabled(ctx, 1, true); err != nil {
+		t.Fatalf("disable: %v", err)
+	}
+	fetcher := &fetcherStub{calls: make(map[string]int)}
+
+	report, err := checkcatalog.New(store, fetcher, checkcatalog.Config{}, nil).Execute(ctx)
+	if err != nil || report.Checked != 0 || len(fetcher.calls) != 0 {
+		t.Fatalf("expected disabled sources to be skipped, got %+v %v %v", report, err, fetcher.calls)
+	}
+}
+
+func TestExecuteRequiresDependencies(t *testing.T) {
+	if _, err := checkcatalog.New(nil, nil, checkcatalog.Config{}, nil).Execute(context.Background()); err == nil {
+		t.Fatal("expected error when dependencies are nil")
+	}
+}
+
+func TestExecuteWrapsStoreErrors(t *testing.T) {
+	uc := checkcatalog.New(failingStore{}, &fetcherStub{calls: make(map[string]int)}, checkcatalog.Config{}, nil)
+	if _, err := uc.Execute(context.Background()); !errors.Is(err, feed.ErrStorage) {
+		t.Fatalf("expected storage error, got %v", err)
+	}
+}
+
+type failingStore struct {
+	repository.CatalogStore
+}
+
+func (failingStore) CatalogURLs(ctx context.Context) ([]string, error) {
+	return nil, errors.New("db down")
+}
@@ -5,26 +5,33 @@ import (
 	"errors"
 	"fmt"
 
+	"rssreader/internal/domain/feed"
 	"rssreader/internal/repository"
 )
 
 // UseCase removes stored feed snapshots.
 type UseCase struct {
-	store repository.FeedStore
+	store    repository.FeedStore
+	fetchLog repository.FetchLogStore
 }
 
-// New constructs the use case with its dependencies.
-func New(store repository.FeedStore) *UseCase {
-	return &UseCase{store: store}
+// New constructs the use case with its dependencies. fetchLog is optional.
+func New(store repository.FeedStore, fetchLog repository.FetchLogStore) *UseCase {
+	return &UseCase{store: store, fetchLog: fetchLog}
 }
 
-// Execute clears all stored feeds.
+// Execute clears all stored feeds along with their fetch history.
 func (uc *UseCase) Execute(ctx context.Context) error {
 	if uc.store == nil {
 		return errors.New("feed store not configured")
 	}
 	if err := uc.store.Clear(ctx); err != nil {
-		return fmt.Errorf("clear feeds: %w", err)
+		return fmt.Errorf("clear feeds: %w: %w", feed.ErrStorage, err)
+	}
+	if uc.fetchLog != nil {
+		if err := uc.fetchLog.ClearFetchHistory(ctx); err != nil {
+			return fmt.Errorf("clear fetch history: %w: %w", feed.ErrStorage, err)
+		}
 	}
 	return nil
 }
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"rssreader/internal/domain/feed"
+	feedRepo "rssreader/internal/infra/feed"
 	"rssreader/internal/usecase/clearfeeds"
 )
 
@@ -17,7 +19,7 @@ func (s storeStub) Save(ctx context.Context, entry *feed.Feed) error {
 	return nil
 }
 
-func (s storeStub) ListRecent(ctx context.Context, limit int) ([]feed.Summary, error) {
+func (s storeStub) ListRecent(ctx context.Context, query feed.RecentQuery) ([]feed.Summary, error) {
 	return nil, nil
 }
 
@@ -25,26 +27,55 @@ func (s storeStub) FindByURL(ctx context.Context, url string) (*feed.Feed, error
 	return nil, nil
 }
 
+func (s storeStub) MarkChecked(ctx context.Context, url string, checkedAt time.Time, ttl time.Duration) error {
+	return nil
+}
+
+func (s storeStub) LookupURL(ctx context.Context, id int64) (string, error) {
+	return "", nil
+}
+
+func (s storeStub) Delete(ctx context.Context, url string) (bool, error) {
+	return false, nil
+}
+
 func (s storeStub) Clear(ctx context.Context) error {
 	return s.clearErr
 }
 
 func TestExecuteClearsFeeds(t *testing.T) {
-	usecase := clearfeeds.New(storeStub{})
+	store := feedRepo.NewMemoryStore()
+	if err := store.Save(context.Background(), &feed.Feed{SourceURL: "https://example.com/rss"}); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	fetchLog := feedRepo.NewMemoryFetchLogStore()
+	if err := fetchLog.RecordFetch(context.Background(), feed.FetchAttempt{SourceURL: "https://example.com/rss"}); err != nil {
+		t.Fatalf("record fetch: %v", err)
+	}
+
+	usecase := clearfeeds.New(store, fetchLog)
 	if err := usecase.Execute(context.Background()); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
+
+	if recent, _ := store.ListRecent(context.Background(), feed.RecentQuery{Limit: 10}); len(recent) != 0 {
+		t.Fatalf("expected feeds to be cleared, got %d", len(recent))
+	}
+	if history, _ := fetchLog.FetchHistory(context.Background(), []string{"https://example.com/rss"}, 10); len(history) != 0 {
+		t.Fatalf("expected fetch history to be cleared, got %+v", history)
+	}
 }
 
 func TestExecuteRequiresStore(t *testing.T) {
-	usecase := clearfeeds.New(nil)
+	usecase := clearfeeds.New(nil, nil)
 	if err := usecase.Execute(context.Background()); err == nil {
 		t.Fatal("expected error when store is nil")
 	}
 }
 
 func TestExecutePropagatesError(t *testing.T) {
-	usecase := clearfeeds.New(storeStub{clearErr: errors.New("db error")})
+	usecase := clearfeeds.New(storeStub{clearErr: errors.New("db error")}, nil)
 	if err := usecase.Execute(context.Background()); err == nil {
 		t.Fatal("expected error when clear fails")
 	}
@@ -0,0 +1,41 @@
+package createfolder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// UseCase creates server-side folders for organizing stored feeds.
+type UseCase struct {
+	store repository.FolderStore
+}
+
+// New constructs the use case with its dependencies.
+func New(store repository.FolderStore) *UseCase {
+	return &UseCase{store: store}
+}
+
+// Execute creates an empty folder with the given name.
+func (uc *UseCase) Execute(ctx context.Context, name string) (*feed.Folder, error) {
+	if uc.store == nil {
+		return nil, errors.New("folder store not configured")
+	}
+
+	name, err := feed.NormalizeFolderName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	folder, err := uc.store.CreateFolder(ctx, name)
+	if errors.Is(err, feed.ErrConflict) {
+		return nil, fmt.Errorf("folder %q %w", name, feed.ErrConflict)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create folder: %w: %w", feed.ErrStorage, err)
+	}
+	return folder, nil
+}
@@ -0,0 +1,43 @@
+package createfolder_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+	feedRepo "rssreader/internal/infra/feed"
+	"rssreader/internal/usecase/createfolder"
+)
+
+func TestExecuteCreatesFolder(t *testing.T) {
+	uc := createfolder.New(feedRepo.NewMemoryStore())
+
+	folder, err := uc.Execute(context.Background(), "  Tecnologia ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if folder.ID == 0 || folder.Name != "Tecnologia" {
+		t.Fatalf("expected trimmed folder name, got %+v", folder)
+	}
+
+	if _, err := uc.Execute(context.Background(), "tecnologia"); !errors.Is(err, feed.ErrConflict) {
+		t.Fatalf("expected conflict for a duplicate name, got %v", err)
+	}
+}
+
+func TestExecuteValidatesName(t *testing.T) {
+	uc := createfolder.New(feedRepo.NewMemoryStore())
+
+	for _, name := range []string{"", "   ", string(make([]rune, feed.MaxFolderNameLength+1))} {
+		if _, err := uc.Execute(context.Background(), name); !errors.Is(err, feed.ErrInvalidInput) {
+			t.Errorf("expected invalid input for %q, got %v", name, err)
+		}
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	if _, err := createfolder.New(nil).Execute(context.Background(), "News"); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
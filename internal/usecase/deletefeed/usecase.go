@@ -0,0 +1,61 @@
+package deletefeed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// ErrNotFound is returned when no stored feed matches the reference.
+var ErrNotFound = fmt.Errorf("feed %w", feed.ErrNotFound)
+
+// UseCase removes a single stored feed along with its items and fetch history.
+type UseCase struct {
+	store    repository.FeedStore
+	fetchLog repository.FetchLogStore
+}
+
+// New constructs the use case with its dependencies. fetchLog is optional.
+func New(store repository.FeedStore, fetchLog repository.FetchLogStore) *UseCase {
+	return &UseCase{store: store, fetchLog: fetchLog}
+}
+
+// Execute deletes the feed identified by ref, either its numeric id or its source URL.
+func (uc *UseCase) Execute(ctx context.Context, ref string) error {
+	if uc.store == nil {
+		return errors.New("feed store not configured")
+	}
+
+	url := strings.TrimSpace(ref)
+	if url == "" {
+		return fmt.Errorf("%w: feed id or url is required", feed.ErrInvalidInput)
+	}
+	if id, err := strconv.ParseInt(url, 10, 64); err == nil {
+		if url, err = uc.store.LookupURL(ctx, id); err != nil {
+			return fmt.Errorf("lookup feed: %w: %w", feed.ErrStorage, err)
+		}
+		if url == "" {
+			return ErrNotFound
+		}
+	}
+
+	deleted, err := uc.store.Delete(ctx, url)
+	if err != nil {
+		return fmt.Errorf("delete feed: %w: %w", feed.ErrStorage, err)
+	}
+	if !deleted {
+		return ErrNotFound
+	}
+
+	if uc.fetchLog != nil {
+		if err := uc.fetchLog.DeleteFetchHistory(ctx, url); err != nil {
+			return fmt.Errorf("delete fetch history: %w: %w", feed.ErrStorage, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package deletefeed_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+	feedRepo "rssreader/internal/infra/feed"
+	"rssreader/internal/usecase/deletefeed"
+)
+
+func seed(t *testing.T, urls ...string) *feedRepo.MemoryStore {
+	t.Helper()
+	store := feedRepo.NewMemoryStore()
+	for _, url := range urls {
+		entry := &feed.Feed{SourceURL: url, Title: "Example", Items: []feed.Item{{GUID: "1", Title: "Item"}}}
+		if err := store.Save(context.Background(), entry); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+	}
+	return store
+}
+
+func TestExecuteDeletesByURLAndID(t *testing.T) {
+	store := seed(t, "https://example.com/a.xml", "https://example.com/b.xml")
+	fetchLog := feedRepo.NewMemoryFetchLogStore()
+	for _, url := range []string{"https://example.com/a.xml", "https://example.com/b.xml"} {
+		if err := fetchLog.RecordFetch(context.Background(), feed.FetchAttempt{SourceURL: url}); err != nil {
+			t.Fatalf("record fetch: %v", err)
+		}
+	}
+	uc := deletefeed.New(store, fetchLog)
+
+	if err := uc.Execute(context.Background(), "https://example.com/a.xml"); err != nil {
+		t.Fatalf("delete by url: %v", err)
+	}
+	history, _ := fetchLog.FetchHistory(context.Background(), []string{"https://example.com/a.xml", "https://example.com/b.xml"}, 10)
+	if _, ok := history["https://example.com/a.xml"]; ok || len(history) != 1 {
+		t.Fatalf("expected only the deleted feed to lose its history, got %+v", history)
+	}
+
+	recent, err := store.ListRecent(context.Background(), feed.RecentQuery{})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(recent) != 1 || recent[0].SourceURL != "https://example.com/b.xml" {
+		t.Fatalf("expected only the other feed to remain, got %+v", recent)
+	}
+
+	if err := uc.Execute(context.Background(), strconv.FormatInt(recent[0].ID, 10)); err != nil {
+		t.Fatalf("delete by id: %v", err)
+	}
+	if found, _ := store.FindByURL(context.Background(), "https://example.com/b.xml"); found != nil {
+		t.Fatal("expected feed deleted by id to be gone")
+	}
+}
+
+func TestExecuteReportsMissingFeeds(t *testing.T) {
+	uc := deletefeed.New(seed(t, "https://example.com/a.xml"), nil)
+
+	for _, ref := range []string{"https://example.com/unknown.xml", "9999"} {
+		if err := uc.Execute(context.Background(), ref); !errors.Is(err, feed.ErrNotFound) {
+			t.Errorf("%s: expected not found, got %v", ref, err)
+		}
+	}
+	if err := uc.Execute(context.Background(), " "); !errors.Is(err, feed.ErrInvalidInput) {
+		t.Errorf("expected invalid input for an empty reference, got %v", err)
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	if err := deletefeed.New(nil, nil).Execute(context.Background(), "1"); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
@@ -0,0 +1,39 @@
+package deletefolder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// ErrNotFound is returned when the folder does not exist.
+var ErrNotFound = fmt.Errorf("folder %w", feed.ErrNotFound)
+
+// UseCase removes server-side folders.
+type UseCase struct {
+	store repository.FolderStore
+}
+
+// New constructs the use case with its dependencies.
+func New(store repository.FolderStore) *UseCase {
+	return &UseCase{store: store}
+}
+
+// Execute deletes the folder; the feeds filed in it are kept, unfiled.
+func (uc *UseCase) Execute(ctx context.Context, id int64) error {
+	if uc.store == nil {
+		return errors.New("folder store not configured")
+	}
+
+	deleted, err := uc.store.DeleteFolder(ctx, id)
+	if err != nil {
+		return fmt.Errorf("delete folder: %w: %w", feed.ErrStorage, err)
+	}
+	if !deleted {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package deletefolder_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+	feedRepo "rssreader/internal/infra/feed"
+	"rssreader/internal/usecase/deletefolder"
+)
+
+func TestExecuteDeletesFolderAndKeepsFeeds(t *testing.T) {
+	ctx := context.Background()
+	store := feedRepo.NewMemoryStore()
+	if err := store.Save(ctx, &feed.Feed{SourceURL: "https://example.com/rss"}); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	folder, _ := store.CreateFolder(ctx, "Tech")
+	if _, err := store.MoveFeed(ctx, 1, folder.ID); err != nil {
+		t.Fatalf("move: %v", err)
+	}
+	uc := deletefolder.New(store)
+
+	if err := uc.Execute(ctx, folder.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := uc.Execute(ctx, folder.ID); !errors.Is(err, feed.ErrNotFound) {
+		t.Fatalf("expected not found on second delete, got %v", err)
+	}
+
+	summaries, err := store.ListRecent(ctx, feed.RecentQuery{})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(summaries) != 1 || summaries[0].FolderID != 0 {
+		t.Fatalf("expected the feed to remain unfiled, got %+v", summaries)
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	if err := deletefolder.New(nil).Execute(context.Background(), 1); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
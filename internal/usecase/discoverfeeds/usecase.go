@@ -0,0 +1,198 @@
+package discoverfeeds
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/mmcdole/gofeed"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// CommonPaths are probed on the site root when a page advertises no feeds.
+var CommonPaths = []string{"/feed", "/rss.xml", "/atom.xml"}
+
+// alternateFormats maps the link types advertised by pages to feed formats.
+var alternateFormats = map[string]string{
+	"application/rss+xml":   feed.FormatRSS,
+	"application/atom+xml":  feed.FormatAtom,
+	"application/feed+json": feed.FormatJSON,
+}
+
+// UseCase finds the feeds offered by a web page.
+type UseCase struct {
+	fetcher repository.FeedFetcher
+}
+
+// New constructs the use case with the required dependencies.
+func New(fetcher repository.FeedFetcher) *UseCase {
+	return &UseCase{fetcher: fetcher}
+}
+
+// Execute returns the feeds offered by the page at rawURL. A URL that already points to a
+// feed yields itself; otherwise the page's <link rel="alternate"> tags are collected and,
+// when there are none, CommonPaths are probed.
+func (uc *UseCase) Execute(ctx context.Context, rawURL string) ([]feed.Candidate, error) {
+	if uc.fetcher == nil {
+		return nil, errors.New("feed fetcher not configured")
+	}
+
+	pageURL, err := feed.NormalizeURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := uc.download(ctx, pageURL)
+	if err != nil {
+		return nil, fmt.Errorf("fetch page: %w", err)
+	}
+
+	if candidate, ok := asFeed(pageURL, payload); ok {
+		return []feed.Candidate{candidate}, nil
+	}
+
+	base, err := url.Parse(pageURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid page url %q", feed.ErrInvalidInput, pageURL)
+	}
+
+	candidates := alternateLinks(base, payload)
+	if len(candidates) > 0 {
+		return candidates, nil
+	}
+
+	for _, path := range CommonPaths {
+		probeURL := base.ResolveReference(&url.URL{Path: path}).String()
+		body, err := uc.download(ctx, probeURL)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("probe %s: %w", probeURL, err)
+			}
+			continue
+		}
+		if candidate, ok := asFeed(probeURL, body); ok {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	return candidates, nil
+}
+
+func (uc *UseCase) download(ctx context.Context, target string) ([]byte, error) {
+	res, err := uc.fetcher.Fetch(ctx, target, feed.Validators{})
+	if err != nil {
+		return nil, err
+	}
+	if res.Body == nil {
+		return nil, nil
+	}
+	defer res.Body.Close()
+	return io.ReadAll(res.Body)
+}
+
+// asFeed reports whether payload is a feed, using its title for the candidate.
+func asFeed(target string, payload []byte) (feed.Candidate, bool) {
+	var format string
+	switch gofeed.DetectFeedType(bytes.NewReader(payload)) {
+	case gofeed.FeedTypeRSS:
+		format = feed.FormatRSS
+	case gofeed.FeedTypeAtom:
+		format = feed.FormatAtom
+	case gofeed.FeedTypeJSON:
+		format = feed.FormatJSON
+	default:
+		return feed.Candidate{}, false
+	}
+
+	parsed, err := gofeed.NewParser().Parse(bytes.NewReader(payload))
+	if err != nil {
+		return feed.Candidate{}, false
+	}
+	return feed.Candidate{URL: target, Title: strings.TrimSpace(parsed.Title), Format: format}, true
+}
+
+// alternateLinks collects the feeds advertised in the document head, resolving
+// relative hrefs against <base href> or the page URL.
+func alternateLinks(base *url.URL, payload []byte) []feed.Candidate {
+	var (
+		candidates []feed.Candidate
+		seen       = make(map[string]bool)
+		tokenizer  = html.NewTokenizer(bytes.NewReader(payload))
+	)
+
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return candidates
+		case html.EndTagToken:
+			if name, _ := tokenizer.TagName(); atom.Lookup(name) == atom.Head {
+				return candidates
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := tokenizer.TagName()
+			tag := atom.Lookup(name)
+			if tag == atom.Body {
+				return candidates
+			}
+			if !hasAttr {
+				continue
+			}
+			attrs := readAttrs(tokenizer)
+
+			switch tag {
+			case atom.Base:
+				if href, err := base.Parse(attrs["href"]); err == nil && attrs["href"] != "" {
+					base = href
+				}
+			case atom.Link:
+				format, ok := alternateFormats[strings.ToLower(strings.TrimSpace(attrs["type"]))]
+				if !ok || !hasToken(attrs["rel"], "alternate") || strings.TrimSpace(attrs["href"]) == "" {
+					continue
+				}
+				resolved, err := base.Parse(strings.TrimSpace(attrs["href"]))
+				if err != nil || (resolved.Scheme != "http" && resolved.Scheme != "https") {
+					continue
+				}
+				target := resolved.String()
+				if seen[target] {
+					continue
+				}
+				seen[target] = true
+				candidates = append(candidates, feed.Candidate{
+					URL:    target,
+					Title:  strings.TrimSpace(attrs["title"]),
+					Format: format,
+				})
+			}
+		}
+	}
+}
+
+func readAttrs(tokenizer *html.Tokenizer) map[string]string {
+	attrs := make(map[string]string)
+	for {
+		key, value, more := tokenizer.TagAttr()
+		attrs[strings.ToLower(string(key))] = string(value)
+		if !more {
+			return attrs
+		}
+	}
+}
+
+// hasToken reports whether the space-separated rel value contains token.
+func hasToken(rel, token string) bool {
+	for _, field := range strings.Fields(rel) {
+		if strings.EqualFold(field, token) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+package discoverfeeds_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+	"rssreader/internal/usecase/discoverfeeds"
+)
+
+const samplePage = `<!doctype html>
+<html>
+<head>
+  <title>Example</title>
+  <base href="https://example.com/blog/">
+  <link rel="stylesheet" href="/style.css">
+  <link rel="alternate" type="application/rss+xml" title="Posts" href="feed.xml">
+  <link rel="Alternate" type="application/atom+xml" title="Atom" href="https://example.com/atom">
+  <link rel="alternate" type="application/feed+json" href="/feed.json">
+  <link rel="alternate" type="application/rss+xml" title="Duplicate" href="feed.xml">
+  <link rel="alternate" hreflang="en" href="/en/">
+</head>
+<body>
+  <link rel="alternate" type="application/rss+xml" href="/ignored.xml">
+</body>
+</html>`
+
+const sampleFeed = `<?xml version="1.0"?>
+<rss version="2.0"><channel><title> Example Feed </title><link>https://example.com</link></channel></rss>`
+
+type fetcherStub struct {
+	pages   map[string]string
+	fetched []string
+}
+
+func (f *fetcherStub) Fetch(ctx context.Context, url string, validators feed.Validators) (*repository.FetchResult, error) {
+	f.fetched = append(f.fetched, url)
+	page, ok := f.pages[url]
+	if !ok {
+		return nil, &feed.UpstreamStatusError{StatusCode: 404}
+	}
+	return &repository.FetchResult{Body: io.NopCloser(strings.NewReader(page))}, nil
+}
+
+func TestExecuteReadsAlternateLinks(t *testing.T) {
+	fetcher := &fetcherStub{pages: map[string]string{"https://example.com/blog/": samplePage}}
+
+	candidates, err := discoverfeeds.New(fetcher).Execute(context.Background(), "https://example.com/blog/")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []feed.Candidate{
+		{URL: "https://example.com/blog/feed.xml", Title: "Posts", Format: feed.FormatRSS},
+		{URL: "https://example.com/atom", Title: "Atom", Format: feed.FormatAtom},
+		{URL: "https://example.com/feed.json", Format: feed.FormatJSON},
+	}
+	if len(candidates) != len(want) {
+		t.Fatalf("expected %d candidates, got %+v", len(want), candidates)
+	}
+	for i := range want {
+		if candidates[i] != want[i] {
+			t.Errorf("candidate %d: got %+v, want %+v", i, candidates[i], want[i])
+		}
+	}
+	if len(fetcher.fetched) != 1 {
+		t.Errorf("expected common paths not to be probed, fetched %v", fetcher.fetched)
+	}
+}
+
+func TestExecuteProbesCommonPaths(t *testing.T) {
+	fetcher := &fetcherStub{pages: map[string]string{
+		"https://example.com/news/article": "<html><head><title>No feeds</title></head></html>",
+		"https://example.com/rss.xml":      sampleFeed,
+	}}
+
+	candidates, err := discoverfeeds.New(fetcher).Execute(context.Background(), "https://example.com/news/article")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(candidates) != 1 || candidates[0] != (feed.Candidate{URL: "https://example.com/rss.xml", Title: "Example Feed", Format: feed.FormatRSS}) {
+		t.Fatalf("unexpected candidates: %+v", candidates)
+	}
+}
+
+func TestExecuteReturnsFeedURLItself(t *testing.T) {
+	fetcher := &fetcherStub{pages: map[string]string{"https://example.com/rss": sampleFeed}}
+
+	candidates, err := discoverfeeds.New(fetcher).Execute(context.Background(), "https://example.com/rss")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(candidates) != 1 || candidates[0].URL != "https://example.com/rss" {
+		t.Fatalf("expected the feed itself, got %+v", candidates)
+	}
+}
+
+func TestExecuteValidatesURL(t *testing.T) {
+	_, err := discoverfeeds.New(&fetcherStub{}).Execute(context.Background(), "example.com")
+	if !errors.Is(err, feed.ErrInvalidInput) {
+		t.Fatalf("expected invalid input, got %v", err)
+	}
+}
+
+func TestExecutePropagatesPageFetchError(t *testing.T) {
+	_, err := discoverfeeds.New(&fetcherStub{}).Execute(context.Background(), "https://example.com/")
+	if !errors.Is(err, feed.ErrUpstreamStatus) {
+		t.Fatalf("expected upstream status error, got %v", err)
+	}
+}
+
+func TestExecuteIgnoresLinksInBody(t *testing.T) {
+	page := `<link rel="alternate" type="application/rss+xml" href="/head.xml"><body><link rel="alternate" type="application/rss+xml" href="/body.xml">`
+	fetcher := &fetcherStub{pages: map[string]string{"https://example.com/": page}}
+
+	candidates, err := discoverfeeds.New(fetcher).Execute(context.Background(), "https://example.com/")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(candidates) != 1 || candidates[0].URL != "https://example.com/head.xml" {
+		t.Fatalf("expected only the head link, got %+v", candidates)
+	}
+}
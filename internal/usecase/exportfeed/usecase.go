@@ -0,0 +1,135 @@
+package exportfeed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// Item limits applied to exported feeds.
+const (
+	DefaultLimit = 50
+	MaxLimit     = 200
+)
+
+// Query selects what to export: a single stored feed by URL, or every subscribed
+// feed within a folder (including nested folders).
+type Query struct {
+	URL    string
+	Folder string
+	Limit  int
+}
+
+// UseCase assembles stored feeds for re-publication in standard formats.
+type UseCase struct {
+	feeds         repository.FeedStore
+	subscriptions repository.SubscriptionStore
+}
+
+// New constructs the use case with its dependencies. Folder exports require subscriptions.
+func New(feeds repository.FeedStore, subscriptions repository.SubscriptionStore) *UseCase {
+	return &UseCase{feeds: feeds, subscriptions: subscriptions}
+}
+
+// Execute returns the stored feed, or a synthetic feed merging the folder's items
+// newest first, capped at the query limit.
+func (uc *UseCase) Execute(ctx context.Context, query Query) (*feed.Feed, error) {
+	if uc.feeds == nil {
+		return nil, errors.New("feed store not configured")
+	}
+
+	limit := query.Limit
+	switch {
+	case limit <= 0:
+		limit = DefaultLimit
+	case limit > MaxLimit:
+		limit = MaxLimit
+	}
+
+	sourceURL := strings.TrimSpace(query.URL)
+	folder := strings.Trim(strings.TrimSpace(query.Folder), "/")
+	switch {
+	case sourceURL != "" && folder != "":
+		return nil, fmt.Errorf("%w: url and folder are mutually exclusive", feed.ErrInvalidInput)
+	case sourceURL != "":
+		return uc.single(ctx, sourceURL, limit)
+	case folder != "":
+		return uc.merged(ctx, folder, limit)
+	default:
+		return nil, fmt.Errorf("%w: url or folder is required", feed.ErrInvalidInput)
+	}
+}
+
+func (uc *UseCase) single(ctx context.Context, sourceURL string, limit int) (*feed.Feed, error) {
+	stored, err := uc.feeds.FindByURL(ctx, sourceURL)
+	if err != nil {
+		return nil, fmt.Errorf("find feed: %w: %w", feed.ErrStorage, err)
+	}
+	if stored == nil {
+		return nil, fmt.Errorf("feed %w", feed.ErrNotFound)
+	}
+
+	if len(stored.Items) > limit {
+		stored.Items = stored.Items[:limit]
+	}
+	return stored, nil
+}
+
+func (uc *UseCase) merged(ctx context.Context, folder string, limit int) (*feed.Feed, error) {
+	if uc.subscriptions == nil {
+		return nil, errors.New("subscription store not configured")
+	}
+
+	subs, err := uc.subscriptions.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list subscriptions: %w: %w", feed.ErrStorage, err)
+	}
+
+	result := &feed.Feed{Title: folder}
+	found := false
+	for _, sub := range subs {
+		if sub.Folder != folder && !strings.HasPrefix(sub.Folder, folder+"/") {
+			continue
+		}
+		found = true
+
+		stored, err := uc.feeds.FindByURL(ctx, sub.SourceURL)
+		if err != nil {
+			return nil, fmt.Errorf("find feed: %w: %w", feed.ErrStorage, err)
+		}
+		if stored == nil {
+			continue
+		}
+
+		for _, item := range stored.Items {
+			if item.FeedURL == "" {
+				item.FeedURL = stored.SourceURL
+			}
+			result.Items = append(result.Items, item)
+		}
+		if stored.FetchedAt.After(result.FetchedAt) {
+			result.FetchedAt = stored.FetchedAt
+		}
+		if stored.UpdatedAt.After(result.UpdatedAt) {
+			result.UpdatedAt = stored.UpdatedAt
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("folder %w", feed.ErrNotFound)
+	}
+
+	sort.SliceStable(result.Items, func(i, j int) bool {
+		return result.Items[i].PublishedAt.After(result.Items[j].PublishedAt)
+	})
+	if len(result.Items) > limit {
+		result.Items = result.Items[:limit]
+	}
+	result.CheckedAt = result.FetchedAt
+
+	return result, nil
+}
@@ -0,0 +1,107 @@
+package exportfeed_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	feedRepo "rssreader/internal/infra/feed"
+	"rssreader/internal/usecase/exportfeed"
+)
+
+func seed(t *testing.T) (*feedRepo.MemoryStore, *feedRepo.MemorySubscriptionStore) {
+	t.Helper()
+	ctx := context.Background()
+	base := time.Date(2024, time.May, 10, 12, 0, 0, 0, time.UTC)
+
+	feeds := feedRepo.NewMemoryStore()
+	subs := feedRepo.NewMemorySubscriptionStore()
+	entries := []struct {
+		url, folder string
+		offset      time.Duration
+	}{
+		{"https://a.example/rss", "Notícias", 0},
+		{"https://b.example/rss", "Notícias/Tecnologia", time.Minute},
+		{"https://c.example/rss", "Esportes", 2 * time.Minute},
+	}
+	for _, entry := range entries {
+		stored := &feed.Feed{
+			SourceURL: entry.url,
+			Title:     entry.url,
+			FetchedAt: base,
+			Items: []feed.Item{
+				{GUID: "old", Title: "old", PublishedAt: base.Add(entry.offset - time.Hour)},
+				{GUID: "new", Title: "new", PublishedAt: base.Add(entry.offset)},
+			},
+		}
+		if err := feeds.Save(ctx, stored); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+		if err := subs.Subscribe(ctx, &feed.Subscription{SourceURL: entry.url, Folder: entry.folder, Interval: time.Hour}); err != nil {
+			t.Fatalf("subscribe: %v", err)
+		}
+	}
+	return feeds, subs
+}
+
+func TestExecuteReturnsStoredFeed(t *testing.T) {
+	feeds, subs := seed(t)
+
+	result, err := exportfeed.New(feeds, subs).Execute(context.Background(), exportfeed.Query{URL: "https://a.example/rss", Limit: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.SourceURL != "https://a.example/rss" || len(result.Items) != 1 {
+		t.Fatalf("expected the stored feed capped at 1 item, got %+v", result)
+	}
+}
+
+func TestExecuteMergesFolder(t *testing.T) {
+	feeds, subs := seed(t)
+
+	result, err := exportfeed.New(feeds, subs).Execute(context.Background(), exportfeed.Query{Folder: "Notícias/"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Title != "Notícias" || len(result.Items) != 4 {
+		t.Fatalf("expected 4 items from the folder and its subfolder, got %d", len(result.Items))
+	}
+	first := result.Items[0]
+	if first.FeedURL != "https://b.example/rss" || first.Title != "new" {
+		t.Errorf("expected newest item first, got %+v", first)
+	}
+	for i := 1; i < len(result.Items); i++ {
+		if result.Items[i].PublishedAt.After(result.Items[i-1].PublishedAt) {
+			t.Fatalf("items not ordered newest first: %+v", result.Items)
+		}
+	}
+}
+
+func TestExecuteReportsNotFound(t *testing.T) {
+	feeds, subs := seed(t)
+	uc := exportfeed.New(feeds, subs)
+
+	for _, query := range []exportfeed.Query{{URL: "https://missing.example/rss"}, {Folder: "Música"}} {
+		if _, err := uc.Execute(context.Background(), query); !errors.Is(err, feed.ErrNotFound) {
+			t.Errorf("%+v: expected not found, got %v", query, err)
+		}
+	}
+}
+
+func TestExecuteValidatesQuery(t *testing.T) {
+	uc := exportfeed.New(feedRepo.NewMemoryStore(), nil)
+
+	for _, query := range []exportfeed.Query{{}, {URL: "https://a.example/rss", Folder: "Notícias"}} {
+		if _, err := uc.Execute(context.Background(), query); !errors.Is(err, feed.ErrInvalidInput) {
+			t.Errorf("%+v: expected invalid input, got %v", query, err)
+		}
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	if _, err := exportfeed.New(nil, nil).Execute(context.Background(), exportfeed.Query{URL: "https://a.example/rss"}); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
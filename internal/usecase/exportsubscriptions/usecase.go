@@ -0,0 +1,76 @@
+package exportsubscriptions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// maxStoredFeeds bounds how many stored, unsubscribed feeds are included in an export.
+const maxStoredFeeds = 1000
+
+// UseCase lists every known feed in a portable form, such as an OPML document.
+type UseCase struct {
+	subscriptions repository.SubscriptionStore
+	feeds         repository.FeedStore
+}
+
+// New constructs the use case with its dependencies.
+func New(subscriptions repository.SubscriptionStore, feeds repository.FeedStore) *UseCase {
+	return &UseCase{subscriptions: subscriptions, feeds: feeds}
+}
+
+// Execute returns the subscribed feeds, keeping their folders, followed by the other stored feeds.
+func (uc *UseCase) Execute(ctx context.Context) ([]feed.Outline, error) {
+	if uc.subscriptions == nil || uc.feeds == nil {
+		return nil, errors.New("feed store not configured")
+	}
+
+	subs, err := uc.subscriptions.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list subscriptions: %w: %w", feed.ErrStorage, err)
+	}
+
+	stored, err := uc.feeds.ListRecent(ctx, feed.RecentQuery{Limit: maxStoredFeeds})
+	if err != nil {
+		return nil, fmt.Errorf("list feeds: %w: %w", feed.ErrStorage, err)
+	}
+
+	byURL := make(map[string]feed.Summary, len(stored))
+	for _, summary := range stored {
+		byURL[summary.SourceURL] = summary
+	}
+
+	outlines := make([]feed.Outline, 0, len(subs)+len(stored))
+	for _, sub := range subs {
+		summary, ok := byURL[sub.SourceURL]
+		delete(byURL, sub.SourceURL)
+
+		title := sub.Title
+		if title == "" && ok {
+			title = summary.Title
+		}
+		outlines = append(outlines, feed.Outline{
+			Title:   title,
+			FeedURL: sub.SourceURL,
+			SiteURL: summary.Link,
+			Folder:  sub.Folder,
+		})
+	}
+
+	for _, summary := range stored {
+		if _, pending := byURL[summary.SourceURL]; !pending {
+			continue
+		}
+		outlines = append(outlines, feed.Outline{
+			Title:   summary.Title,
+			FeedURL: summary.SourceURL,
+			SiteURL: summary.Link,
+		})
+	}
+
+	return outlines, nil
+}
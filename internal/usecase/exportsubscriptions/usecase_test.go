@@ -0,0 +1,107 @@
+package exportsubscriptions_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/usecase/exportsubscriptions"
+)
+
+type subscriptionStub struct {
+	subs []feed.Subscription
+	err  error
+}
+
+func (s subscriptionStub) Subscribe(ctx context.Context, entry *feed.Subscription) error {
+	return nil
+}
+
+func (s subscriptionStub) Unsubscribe(ctx context.Context, url string) error {
+	return nil
+}
+
+func (s subscriptionStub) List(ctx context.Context) ([]feed.Subscription, error) {
+	return s.subs, s.err
+}
+
+func (s subscriptionStub) ListDue(ctx context.Context, now time.Time, limit int) ([]feed.Subscription, error) {
+	return nil, nil
+}
+
+func (s subscriptionStub) MarkPolled(ctx context.Context, id int64, polledAt time.Time, pollErr error) error {
+	return nil
+}
+
+type feedStub struct {
+	feeds []feed.Summary
+	err   error
+}
+
+func (s feedStub) Save(ctx context.Context, entry *feed.Feed) error {
+	return nil
+}
+
+func (s feedStub) ListRecent(ctx context.Context, query feed.RecentQuery) ([]feed.Summary, error) {
+	return s.feeds, s.err
+}
+
+func (s feedStub) FindByURL(ctx context.Context, url string) (*feed.Feed, error) {
+	return nil, nil
+}
+
+func (s feedStub) MarkChecked(ctx context.Context, url string, checkedAt time.Time, ttl time.Duration) error {
+	return nil
+}
+
+func (s feedStub) LookupURL(ctx context.Context, id int64) (string, error) {
+	return "", nil
+}
+
+func (s feedStub) Delete(ctx context.Context, url string) (bool, error) {
+	return false, nil
+}
+
+func (s feedStub) Clear(ctx context.Context) error {
+	return nil
+}
+
+func TestExecuteMergesSubscriptionsAndStoredFeeds(t *testing.T) {
+	subs := subscriptionStub{subs: []feed.Subscription{
+		{SourceURL: "https://news.example.com/rss", Folder: "Notícias"},
+	}}
+	feeds := feedStub{feeds: []feed.Summary{
+		{SourceURL: "https://other.example.com/rss", Title: "Other", Link: "https://other.example.com"},
+		{SourceURL: "https://news.example.com/rss", Title: "News", Link: "https://news.example.com"},
+	}}
+
+	outlines, err := exportsubscriptions.New(subs, feeds).Execute(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(outlines) != 2 {
+		t.Fatalf("expected 2 outlines, got %+v", outlines)
+	}
+	if got := outlines[0]; got.Title != "News" || got.Folder != "Notícias" || got.SiteURL != "https://news.example.com" {
+		t.Errorf("unexpected subscribed outline: %+v", got)
+	}
+	if got := outlines[1]; got.FeedURL != "https://other.example.com/rss" || got.Folder != "" {
+		t.Errorf("unexpected stored outline: %+v", got)
+	}
+}
+
+func TestExecuteRequiresStores(t *testing.T) {
+	if _, err := exportsubscriptions.New(nil, nil).Execute(context.Background()); err == nil {
+		t.Fatal("expected error when stores are nil")
+	}
+}
+
+func TestExecutePropagatesStoreError(t *testing.T) {
+	uc := exportsubscriptions.New(subscriptionStub{err: errors.New("db error")}, feedStub{})
+	if _, err := uc.Execute(context.Background()); err == nil {
+		t.Fatal("expected error when store fails")
+	}
+}
@@ -0,0 +1,78 @@
+package feedhistory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// Page size limits for the fetch history.
+const (
+	DefaultLimit = 20
+	MaxLimit     = feed.FetchLogRetention
+)
+
+// ErrNotFound is returned when no stored feed has the requested id.
+var ErrNotFound = fmt.Errorf("feed %w", feed.ErrNotFound)
+
+// History is the health of a stored feed along with its latest fetch attempts,
+// newest first.
+type History struct {
+	SourceURL string
+	Health    string
+	Attempts  []feed.FetchAttempt
+}
+
+// UseCase reports how the downloads of a stored feed went recently.
+type UseCase struct {
+	store    repository.FeedStore
+	fetchLog repository.FetchLogStore
+}
+
+// New constructs the use case with the required dependencies.
+func New(store repository.FeedStore, fetchLog repository.FetchLogStore) *UseCase {
+	return &UseCase{store: store, fetchLog: fetchLog}
+}
+
+// Execute returns up to limit attempts of the feed with the given id. The health
+// status always considers the latest feed.HealthWindow attempts.
+func (uc *UseCase) Execute(ctx context.Context, id int64, limit int) (*History, error) {
+	if uc.store == nil || uc.fetchLog == nil {
+		return nil, errors.New("fetch log not configured")
+	}
+	if id <= 0 {
+		return nil, fmt.Errorf("%w: invalid feed id", feed.ErrInvalidInput)
+	}
+	switch {
+	case limit <= 0:
+		limit = DefaultLimit
+	case limit > MaxLimit:
+		limit = MaxLimit
+	}
+
+	url, err := uc.store.LookupURL(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("lookup feed: %w: %w", feed.ErrStorage, err)
+	}
+	if url == "" {
+		return nil, ErrNotFound
+	}
+
+	history, err := uc.fetchLog.FetchHistory(ctx, []string{url}, max(limit, feed.HealthWindow))
+	if err != nil {
+		return nil, fmt.Errorf("fetch history: %w: %w", feed.ErrStorage, err)
+	}
+
+	attempts := history[url]
+	result := &History{SourceURL: url, Health: feed.HealthOf(attempts), Attempts: attempts}
+	if len(attempts) > limit {
+		result.Attempts = attempts[:limit]
+	}
+	if result.Attempts == nil {
+		result.Attempts = []feed.FetchAttempt{}
+	}
+	return result, nil
+}
@@ -0,0 +1,64 @@
+package feedhistory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+	feedRepo "rssreader/internal/infra/feed"
+	"rssreader/internal/usecase/feedhistory"
+)
+
+func seed(t *testing.T) (*feedRepo.MemoryStore, *feedRepo.MemoryFetchLogStore, int64) {
+	t.Helper()
+	ctx := context.Background()
+	store := feedRepo.NewMemoryStore()
+	if err := store.Save(ctx, &feed.Feed{SourceURL: "https://example.com/rss", Title: "Example"}); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	recent, err := store.ListRecent(ctx, feed.RecentQuery{})
+	if err != nil || len(recent) != 1 {
+		t.Fatalf("list: %v", err)
+	}
+
+	fetchLog := feedRepo.NewMemoryFetchLogStore()
+	classes := []string{"", "", feed.FetchErrorTimeout, feed.FetchErrorTimeout, feed.FetchErrorTimeout}
+	for i, class := range classes {
+		attempt := feed.FetchAttempt{SourceURL: "https://example.com/rss", ErrorClass: class, ItemDelta: i}
+		if err := fetchLog.RecordFetch(ctx, attempt); err != nil {
+			t.Fatalf("record fetch: %v", err)
+		}
+	}
+	return store, fetchLog, recent[0].ID
+}
+
+func TestExecuteReturnsLatestAttempts(t *testing.T) {
+	store, fetchLog, id := seed(t)
+
+	history, err := feedhistory.New(store, fetchLog).Execute(context.Background(), id, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if history.SourceURL != "https://example.com/rss" || history.Health != feed.HealthFailing {
+		t.Fatalf("unexpected history %+v", history)
+	}
+	if len(history.Attempts) != 2 || history.Attempts[0].ItemDelta != 4 || history.Attempts[1].ItemDelta != 3 {
+		t.Fatalf("expected the two latest attempts, got %+v", history.Attempts)
+	}
+}
+
+func TestExecuteReportsMissingFeeds(t *testing.T) {
+	store, fetchLog, _ := seed(t)
+	uc := feedhistory.New(store, fetchLog)
+
+	if _, err := uc.Execute(context.Background(), 99, 0); !errors.Is(err, feedhistory.ErrNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	if _, err := uc.Execute(context.Background(), 0, 0); !errors.Is(err, feed.ErrInvalidInput) {
+		t.Fatalf("expected invalid input, got %v", err)
+	}
+	if _, err := feedhistory.New(store, nil).Execute(context.Background(), 1, 0); err == nil {
+		t.Fatal("expected an error without a fetch log")
+	}
+}
@@ -0,0 +1,80 @@
+package fetchfeed
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"rssreader/internal/domain/feed"
+)
+
+// attempt gathers what is logged about a single download while it runs.
+type attempt struct {
+	feed.FetchAttempt
+	started     time.Time
+	servedStale bool
+	// stored reports that a snapshot of the feed is stored, before or after the download.
+	stored bool
+}
+
+func (uc *UseCase) begin(url string, stored bool) *attempt {
+	started := uc.clock()
+	return &attempt{
+		FetchAttempt: feed.FetchAttempt{SourceURL: url, AttemptedAt: started},
+		started:      started,
+		stored:       stored,
+	}
+}
+
+// fail marks the attempt failed with err; a nil err leaves it untouched.
+func (a *attempt) fail(err error) {
+	if err == nil {
+		return
+	}
+	a.ErrorClass = feed.ClassifyFetchError(err)
+	a.Error = err.Error()
+
+	var statusErr *feed.UpstreamStatusError
+	if errors.As(err, &statusErr) {
+		a.StatusCode = statusErr.StatusCode
+	}
+}
+
+// succeed records the items of the new snapshot that the previous one lacked.
+func (a *attempt) succeed(previous, current *feed.Feed) {
+	known := make(map[string]bool)
+	if previous != nil {
+		for _, item := range previous.Items {
+			known[item.Key()] = true
+		}
+	}
+	for _, item := range current.Items {
+		if !known[item.Key()] {
+			a.ItemDelta++
+		}
+	}
+}
+
+// finish reports and stores the attempt. A failure to log is reported but never fails
+// the fetch, and the attempt is logged even when the caller has gone away.
+func (uc *UseCase) finish(ctx context.Context, a *attempt) {
+	if uc.fetchLog == nil && uc.observer == nil {
+		return
+	}
+	a.Duration = uc.clock().Sub(a.started)
+
+	if uc.observer != nil {
+		uc.observer.ObserveFetch(a.FetchAttempt, a.servedStale)
+	}
+	// History is only kept for stored feeds, and invalid URLs never reached a publisher.
+	if uc.fetchLog == nil || !a.stored || a.ErrorClass == feed.FetchErrorInvalid {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if err := uc.fetchLog.RecordFetch(ctx, a.FetchAttempt); err != nil {
+		log.Printf("fetch feed: record attempt for %s: %v", a.SourceURL, err)
+	}
+}
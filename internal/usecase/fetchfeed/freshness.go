@@ -0,0 +1,87 @@
+package fetchfeed
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/mmcdole/gofeed/rss"
+
+	"rssreader/internal/repository"
+)
+
+// lifetime resolves how long a snapshot may be served without contacting the
+// publisher: Cache-Control wins over the lifetime announced in the feed, which wins
+// over the configured default. Announced lifetimes are capped at maxPublisherTTL.
+func (uc *UseCase) lifetime(res *repository.FetchResult, announced time.Duration) time.Duration {
+	if uc.cfg.MaxAge <= 0 {
+		return 0
+	}
+	switch {
+	case res.HasMaxAge:
+		return min(res.MaxAge, maxPublisherTTL)
+	case announced > 0:
+		return min(announced, maxPublisherTTL)
+	default:
+		return uc.cfg.MaxAge
+	}
+}
+
+// syndicationPeriods maps sy:updatePeriod values to durations.
+var syndicationPeriods = map[string]time.Duration{
+	"hourly":  time.Hour,
+	"daily":   24 * time.Hour,
+	"weekly":  7 * 24 * time.Hour,
+	"monthly": 30 * 24 * time.Hour,
+	"yearly":  365 * 24 * time.Hour,
+}
+
+// publisherTTL returns the update interval announced by the feed through the RSS
+// <ttl> element or the syndication module, or zero when it announces none.
+func publisherTTL(parsed *gofeed.Feed) time.Duration {
+	if parsed == nil {
+		return 0
+	}
+	if minutes, err := strconv.Atoi(strings.TrimSpace(parsed.Custom[ttlKey])); err == nil && minutes > 0 {
+		return time.Duration(minutes) * time.Minute
+	}
+
+	sy := parsed.Extensions["sy"]
+	if len(sy["updatePeriod"]) == 0 {
+		return 0
+	}
+	period, ok := syndicationPeriods[strings.ToLower(strings.TrimSpace(sy["updatePeriod"][0].Value))]
+	if !ok {
+		return 0
+	}
+	frequency := 1
+	if values := sy["updateFrequency"]; len(values) > 0 {
+		if parsedFrequency, err := strconv.Atoi(strings.TrimSpace(values[0].Value)); err == nil && parsedFrequency > 0 {
+			frequency = parsedFrequency
+		}
+	}
+	return period / time.Duration(frequency)
+}
+
+// ttlKey stores the RSS <ttl> among the custom values of the universal feed.
+const ttlKey = "ttl"
+
+// rssTranslator keeps the channel <ttl>, which the universal feed drops.
+type rssTranslator struct {
+	gofeed.DefaultRSSTranslator
+}
+
+func (t *rssTranslator) Translate(in interface{}) (*gofeed.Feed, error) {
+	result, err := t.DefaultRSSTranslator.Translate(in)
+	if err != nil {
+		return nil, err
+	}
+	if channel, ok := in.(*rss.Feed); ok && strings.TrimSpace(channel.TTL) != "" {
+		if result.Custom == nil {
+			result.Custom = make(map[string]string)
+		}
+		result.Custom[ttlKey] = channel.TTL
+	}
+	return result, nil
+}
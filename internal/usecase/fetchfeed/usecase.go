@@ -2,82 +2,328 @@ package fetchfeed
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"io"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/mmcdole/gofeed"
+	"golang.org/x/sync/singleflight"
 
 	"rssreader/internal/domain/feed"
 	"rssreader/internal/repository"
 )
 
+// Config tunes how long stored snapshots are served without contacting the publisher.
+type Config struct {
+	// MaxAge is the freshness lifetime of a snapshot when the publisher states none.
+	// Zero disables freshness: every request downloads the feed again.
+	MaxAge time.Duration
+	// FetchTimeout bounds a download. Concurrent callers share one download, so it
+	// runs under this timeout instead of the deadline of the caller that started it.
+	// Zero selects DefaultFetchTimeout.
+	FetchTimeout time.Duration
+}
+
+// DefaultFetchTimeout is the FetchTimeout used when none is configured.
+const DefaultFetchTimeout = 30 * time.Second
+
+// maxPublisherTTL caps the lifetimes announced by publishers, so a feed declaring a
+// weekly schedule is still checked daily.
+const maxPublisherTTL = 24 * time.Hour
+
+// Result is the feed served by Execute along with how current it is.
+type Result struct {
+	Feed *feed.Feed
+	// Stale reports that downloading the feed failed and Feed is the stored snapshot
+	// served in its place; UpstreamErr is the download failure.
+	Stale       bool
+	UpstreamErr error
+	// Age is how long ago the publisher last confirmed Feed; it is zero right after a
+	// download or revalidation.
+	Age time.Duration
+}
+
+// Observer is told about every download attempt once it completes, for instance to
+// export metrics. servedStale reports that the stored snapshot was served in its place.
+type Observer interface {
+	ObserveFetch(attempt feed.FetchAttempt, servedStale bool)
+}
+
 // UseCase orchestrates parsing an RSS feed from a given URL.
 type UseCase struct {
-	fetcher repository.FeedFetcher
-	store   repository.FeedStore
-	parser  feedParser
-	clock   func() time.Time
+	fetcher  repository.FeedFetcher
+	store    repository.FeedStore
+	fetchLog repository.FetchLogStore
+	observer Observer
+	parser   feedParser
+	cfg      Config
+	clock    func() time.Time
+	flights  singleflight.Group
 }
 
 type feedParser interface {
-	ParseString(input string) (*gofeed.Feed, error)
+	Parse(feed io.Reader) (*gofeed.Feed, error)
 }
 
-// New creates a new UseCase instance.
-func New(fetcher repository.FeedFetcher, store repository.FeedStore, clock func() time.Time) *UseCase {
+// New creates a new UseCase instance. When not nil, observer is told about every
+// download and fetchLog records those of stored feeds.
+func New(fetcher repository.FeedFetcher, store repository.FeedStore, fetchLog repository.FetchLogStore, observer Observer, cfg Config, clock func() time.Time) *UseCase {
 	if clock == nil {
 		clock = time.Now
 	}
+	if cfg.FetchTimeout <= 0 {
+		cfg.FetchTimeout = DefaultFetchTimeout
+	}
+	parser := gofeed.NewParser()
+	parser.RSSTranslator = &rssTranslator{}
 	return &UseCase{
-		fetcher: fetcher,
-		store:   store,
-		parser:  gofeed.NewParser(),
-		clock:   clock,
+		fetcher:  fetcher,
+		store:    store,
+		fetchLog: fetchLog,
+		observer: observer,
+		parser:   parser,
+		cfg:      cfg,
+		clock:    clock,
 	}
 }
 
-// Execute downloads and parses the feed for the provided URL.
-func (uc *UseCase) Execute(ctx context.Context, url string) (*feed.Feed, error) {
+// Execute returns the feed for the provided URL. A stored snapshot that is still
+// fresh is served as is; otherwise the feed is downloaded and parsed, falling back to
+// the stored snapshot, marked stale, when the download fails. Concurrent calls for the
+// same URL share a single lookup and download, bounded by Config.FetchTimeout. A caller
+// whose ctx ends first is served the stored snapshot, marked stale, or an
+// ErrUpstreamTimeout when there is none, while the download carries on for the others.
+func (uc *UseCase) Execute(ctx context.Context, url string) (*Result, error) {
 	trimmedURL := strings.TrimSpace(url)
 	if trimmedURL == "" {
-		return nil, errors.New("url is required")
+		return nil, fmt.Errorf("%w: url is required", feed.ErrInvalidInput)
+	}
+
+	flight := uc.flights.DoChan(trimmedURL, func() (any, error) {
+		// A caller giving up must not abort the download other callers wait for.
+		shared, cancel := context.WithTimeout(context.WithoutCancel(ctx), uc.cfg.FetchTimeout)
+		defer cancel()
+		return uc.execute(shared, trimmedURL)
+	})
+
+	select {
+	case <-ctx.Done():
+		return uc.abandoned(ctx, trimmedURL)
+	case res := <-flight:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*Result), nil
 	}
+}
 
-	raw, err := uc.fetcher.Fetch(ctx, trimmedURL)
+func (uc *UseCase) execute(ctx context.Context, trimmedURL string) (*Result, error) {
+	var (
+		cached   *feed.Feed
+		cacheErr error
+	)
+	if uc.store != nil {
+		cached, cacheErr = uc.store.FindByURL(ctx, trimmedURL)
+	}
+	if now := uc.clock(); uc.cfg.MaxAge > 0 && cached.FreshAt(now) {
+		return &Result{Feed: cached, Age: cached.AgeAt(now)}, nil
+	}
+
+	attempt := uc.begin(trimmedURL, cached != nil)
+	defer uc.finish(ctx, attempt)
+
+	res, err := uc.fetcher.Fetch(ctx, trimmedURL, cached.Validators())
 	if err != nil {
-		if uc.store != nil {
-			cached, cacheErr := uc.store.FindByURL(ctx, trimmedURL)
-			if cacheErr == nil && cached != nil {
-				return cached, nil
-			}
-			if cacheErr != nil {
-				return nil, fmt.Errorf("fetch feed: %v (fallback lookup failed: %w)", err, cacheErr)
-			}
+		attempt.fail(err)
+		if cached != nil {
+			return uc.stale(attempt, cached, err), nil
+		}
+		if cacheErr != nil {
+			return nil, fmt.Errorf("fetch feed: %w (fallback lookup failed: %w)", err, cacheErr)
 		}
 		return nil, fmt.Errorf("fetch feed: %w", err)
 	}
 
-	parsed, err := uc.parser.ParseString(string(raw))
+	attempt.StatusCode = res.StatusCode
+
+	if res.NotModified {
+		result, err := uc.revalidated(ctx, cached, res)
+		if err != nil {
+			attempt.fail(err)
+			return nil, err
+		}
+		return &Result{Feed: result}, nil
+	}
+
+	parsed, readErr, err := uc.parse(res, attempt)
+	if readErr != nil {
+		attempt.fail(readErr)
+		if cached != nil {
+			return uc.stale(attempt, cached, readErr), nil
+		}
+		return nil, fmt.Errorf("fetch feed: %w", readErr)
+	}
+	if err != nil {
+		err = fmt.Errorf("parse feed: %w: %w", feed.ErrUnparseableFeed, err)
+		attempt.fail(err)
+		return nil, err
+	}
+
+	result, err := uc.save(ctx, trimmedURL, parsed, res)
+	if err != nil {
+		attempt.fail(err)
+		return nil, err
+	}
+	attempt.stored = uc.store != nil
+	attempt.succeed(cached, result)
+	return &Result{Feed: result}, nil
+}
+
+// stale serves the stored snapshot in place of a feed that could not be downloaded.
+func (uc *UseCase) stale(a *attempt, cached *feed.Feed, upstreamErr error) *Result {
+	a.servedStale = true
+	return &Result{Feed: cached, Stale: true, UpstreamErr: upstreamErr, Age: cached.AgeAt(uc.clock())}
+}
+
+// abandoned answers a caller whose ctx ended before the shared download completed,
+// falling back to the stored snapshot like a failed download does.
+func (uc *UseCase) abandoned(ctx context.Context, url string) (*Result, error) {
+	err := fmt.Errorf("fetch feed: %w: %w", feed.ErrUpstreamTimeout, ctx.Err())
+	if uc.store == nil {
+		return nil, err
+	}
+
+	// The caller's ctx is already done; the lookup gets a short budget of its own.
+	lookup, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second)
+	defer cancel()
+	cached, lookupErr := uc.store.FindByURL(lookup, url)
+	if lookupErr != nil || cached == nil {
+		return nil, err
+	}
+	return &Result{Feed: cached, Stale: true, UpstreamErr: err, Age: cached.AgeAt(uc.clock())}, nil
+}
+
+// Refresh downloads the feed unconditionally, ignoring stored validators, and
+// replaces the stored snapshot. Unlike Execute it never falls back to the cache.
+func (uc *UseCase) Refresh(ctx context.Context, url string) (*feed.Feed, error) {
+	trimmedURL := strings.TrimSpace(url)
+	if trimmedURL == "" {
+		return nil, fmt.Errorf("%w: url is required", feed.ErrInvalidInput)
+	}
+
+	var previous *feed.Feed
+	if uc.store != nil && uc.fetchLog != nil {
+		// Only needed for the fetch log: new items are counted against it, and failures
+		// are only logged for stored feeds.
+		previous, _ = uc.store.FindByURL(ctx, trimmedURL)
+	}
+
+	attempt := uc.begin(trimmedURL, previous != nil)
+	defer uc.finish(ctx, attempt)
+
+	result, err := uc.refresh(ctx, trimmedURL, attempt)
+	if err != nil {
+		attempt.fail(err)
+		return nil, err
+	}
+	attempt.stored = uc.store != nil
+	attempt.succeed(previous, result)
+	return result, nil
+}
+
+func (uc *UseCase) refresh(ctx context.Context, url string, attempt *attempt) (*feed.Feed, error) {
+	res, err := uc.fetcher.Fetch(ctx, url, feed.Validators{})
+	if err != nil {
+		return nil, fmt.Errorf("fetch feed: %w", err)
+	}
+	attempt.StatusCode = res.StatusCode
+	if res.NotModified {
+		return nil, fmt.Errorf("fetch feed: %w: not modified response to an unconditional request", feed.ErrUpstreamStatus)
+	}
+
+	parsed, readErr, err := uc.parse(res, attempt)
+	if readErr != nil {
+		return nil, fmt.Errorf("fetch feed: %w", readErr)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("parse feed: %w", err)
+		return nil, fmt.Errorf("parse feed: %w: %w", feed.ErrUnparseableFeed, err)
 	}
 
+	return uc.save(ctx, url, parsed, res)
+}
+
+// parse consumes and closes the payload, counting the bytes read in attempt. When
+// parsing fails because the stream could not be read (size limit, dropped connection),
+// the failure is a fetch error rather than a malformed feed and is returned as readErr.
+func (uc *UseCase) parse(res *repository.FetchResult, attempt *attempt) (parsed *gofeed.Feed, readErr, err error) {
+	body := &readRecorder{r: res.Body}
+	parsed, err = uc.parser.Parse(body)
+	res.Body.Close()
+	attempt.Bytes = body.n
+	if err != nil && body.err != nil {
+		return nil, body.err, nil
+	}
+	return parsed, nil, err
+}
+
+// save stores the parsed feed as the latest snapshot for url.
+func (uc *UseCase) save(ctx context.Context, url string, parsed *gofeed.Feed, res *repository.FetchResult) (*feed.Feed, error) {
 	fetchedAt := uc.clock()
 	result := transformFeed(parsed, uc.clock)
-	result.SourceURL = trimmedURL
+	result.SourceURL = url
 	result.FetchedAt = fetchedAt
+	result.CheckedAt = fetchedAt
+	result.ETag = res.ETag
+	result.LastModified = res.LastModified
+	result.TTL = uc.lifetime(res, publisherTTL(parsed))
 
 	if uc.store != nil {
 		if err := uc.store.Save(ctx, result); err != nil {
-			return nil, fmt.Errorf("save feed: %w", err)
+			return nil, fmt.Errorf("save feed: %w: %w", feed.ErrStorage, err)
 		}
 	}
 
 	return result, nil
 }
 
+// revalidated serves the stored snapshot after the publisher answered 304 Not Modified.
+// The lifetime announced in the feed body is unknown, so the stored one is kept unless
+// the response carries Cache-Control.
+func (uc *UseCase) revalidated(ctx context.Context, cached *feed.Feed, res *repository.FetchResult) (*feed.Feed, error) {
+	if cached == nil {
+		return nil, fmt.Errorf("fetch feed: %w: not modified response without a stored snapshot", feed.ErrUpstreamStatus)
+	}
+
+	checkedAt := uc.clock()
+	ttl := uc.lifetime(res, cached.TTL)
+	if err := uc.store.MarkChecked(ctx, cached.SourceURL, checkedAt, ttl); err != nil {
+		return nil, fmt.Errorf("mark feed checked: %w: %w", feed.ErrStorage, err)
+	}
+	cached.CheckedAt = checkedAt
+	cached.TTL = ttl
+
+	return cached, nil
+}
+
+// readRecorder remembers the first error other than io.EOF returned by the wrapped reader,
+// since the parser does not always wrap it, and counts the bytes read.
+type readRecorder struct {
+	r   io.Reader
+	n   int64
+	err error
+}
+
+func (r *readRecorder) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	if err != nil && err != io.EOF && r.err == nil {
+		r.err = err
+	}
+	return n, err
+}
+
 func transformFeed(parsed *gofeed.Feed, clock func() time.Time) *feed.Feed {
 	if parsed == nil {
 		return &feed.Feed{}
@@ -90,21 +336,100 @@ func transformFeed(parsed *gofeed.Feed, clock func() time.Time) *feed.Feed {
 		}
 
 		published := resolvePublishedAt(item, clock)
+		enclosures := transformEnclosures(item.Enclosures)
 
 		items = append(items, feed.Item{
+			GUID:        strings.TrimSpace(item.GUID),
 			Title:       strings.TrimSpace(item.Title),
 			Link:        strings.TrimSpace(item.Link),
 			Description: strings.TrimSpace(item.Description),
+			Content:     strings.TrimSpace(item.Content),
+			Authors:     authorNames(item.Authors),
+			Categories:  trimAll(item.Categories),
+			Image:       resolveItemImage(item, enclosures),
+			Enclosures:  enclosures,
 			PublishedAt: published,
+			UpdatedAt:   timeOrZero(item.UpdatedParsed),
 		})
 	}
 
-	return &feed.Feed{
+	result := &feed.Feed{
 		Title:       strings.TrimSpace(parsed.Title),
 		Description: strings.TrimSpace(parsed.Description),
 		Link:        strings.TrimSpace(parsed.Link),
+		Authors:     authorNames(parsed.Authors),
+		Categories:  trimAll(parsed.Categories),
+		UpdatedAt:   timeOrZero(parsed.UpdatedParsed),
 		Items:       items,
 	}
+	if parsed.Image != nil {
+		result.Image = strings.TrimSpace(parsed.Image.URL)
+	}
+
+	return result
+}
+
+func authorNames(people []*gofeed.Person) []string {
+	var names []string
+	for _, person := range people {
+		if person == nil {
+			continue
+		}
+		name := strings.TrimSpace(person.Name)
+		if name == "" {
+			name = strings.TrimSpace(person.Email)
+		}
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func trimAll(values []string) []string {
+	var result []string
+	for _, value := range values {
+		if trimmed := strings.TrimSpace(value); trimmed != "" {
+			result = append(result, trimmed)
+		}
+	}
+	return result
+}
+
+func transformEnclosures(enclosures []*gofeed.Enclosure) []feed.Enclosure {
+	var result []feed.Enclosure
+	for _, enclosure := range enclosures {
+		if enclosure == nil || strings.TrimSpace(enclosure.URL) == "" {
+			continue
+		}
+		length, _ := strconv.ParseInt(strings.TrimSpace(enclosure.Length), 10, 64)
+		result = append(result, feed.Enclosure{
+			URL:    strings.TrimSpace(enclosure.URL),
+			Type:   strings.TrimSpace(enclosure.Type),
+			Length: length,
+		})
+	}
+	return result
+}
+
+// resolveItemImage prefers the item image and falls back to the first image enclosure.
+func resolveItemImage(item *gofeed.Item, enclosures []feed.Enclosure) string {
+	if item.Image != nil && strings.TrimSpace(item.Image.URL) != "" {
+		return strings.TrimSpace(item.Image.URL)
+	}
+	for _, enclosure := range enclosures {
+		if strings.HasPrefix(enclosure.Type, "image/") {
+			return enclosure.URL
+		}
+	}
+	return ""
+}
+
+func timeOrZero(value *time.Time) time.Time {
+	if value == nil {
+		return time.Time{}
+	}
+	return *value
 }
 
 func resolvePublishedAt(item *gofeed.Item, clock func() time.Time) time.Time {
@@ -1,12 +1,21 @@
 package fetchfeed_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	"rssreader/internal/domain/feed"
+	"rssreader/internal/infra/httpclient"
+	"rssreader/internal/repository"
 	"rssreader/internal/usecase/fetchfeed"
 )
 
@@ -31,12 +40,43 @@ const sampleFeed = `<?xml version="1.0" encoding="UTF-8"?>
 </rss>`
 
 type fetcherStub struct {
-	payload []byte
-	err     error
+	payload     []byte
+	body        io.Reader
+	err         error
+	etag        string
+	notModified bool
+	validators  *feed.Validators
+	maxAge      time.Duration
+	hasMaxAge   bool
+	calls       *atomic.Int32
+	release     chan struct{}
 }
 
-func (f fetcherStub) Fetch(ctx context.Context, url string) ([]byte, error) {
-	return f.payload, f.err
+func (f fetcherStub) Fetch(ctx context.Context, url string, validators feed.Validators) (*repository.FetchResult, error) {
+	if f.calls != nil {
+		f.calls.Add(1)
+	}
+	if f.release != nil {
+		select {
+		case <-f.release:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w: %w", feed.ErrUpstreamTimeout, ctx.Err())
+		}
+	}
+	if f.validators != nil {
+		*f.validators = validators
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.notModified {
+		return &repository.FetchResult{StatusCode: 304, ETag: f.etag, NotModified: true, MaxAge: f.maxAge, HasMaxAge: f.hasMaxAge}, nil
+	}
+	body := f.body
+	if body == nil {
+		body = bytes.NewReader(f.payload)
+	}
+	return &repository.FetchResult{Body: io.NopCloser(body), StatusCode: 200, ETag: f.etag, MaxAge: f.maxAge, HasMaxAge: f.hasMaxAge}, nil
 }
 
 type storeStub struct {
@@ -44,6 +84,8 @@ type storeStub struct {
 	saveErr  error
 	findFeed *feed.Feed
 	findErr  error
+	checked  []time.Time
+	ttls     []time.Duration
 }
 
 func (s *storeStub) Save(ctx context.Context, entry *feed.Feed) error {
@@ -54,7 +96,7 @@ func (s *storeStub) Save(ctx context.Context, entry *feed.Feed) error {
 	return nil
 }
 
-func (s *storeStub) ListRecent(ctx context.Context, limit int) ([]feed.Summary, error) {
+func (s *storeStub) ListRecent(ctx context.Context, query feed.RecentQuery) ([]feed.Summary, error) {
 	return nil, nil
 }
 
@@ -65,21 +107,67 @@ func (s *storeStub) FindByURL(ctx context.Context, url string) (*feed.Feed, erro
 	return s.findFeed, nil
 }
 
+func (s *storeStub) MarkChecked(ctx context.Context, url string, checkedAt time.Time, ttl time.Duration) error {
+	s.checked = append(s.checked, checkedAt)
+	s.ttls = append(s.ttls, ttl)
+	return nil
+}
+
+func (s *storeStub) LookupURL(ctx context.Context, id int64) (string, error) {
+	return "", nil
+}
+
+func (s *storeStub) Delete(ctx context.Context, url string) (bool, error) {
+	return false, nil
+}
+
 func (s *storeStub) Clear(ctx context.Context) error {
 	return nil
 }
 
+type fetchLogStub struct {
+	attempts []feed.FetchAttempt
+}
+
+func (s *fetchLogStub) RecordFetch(ctx context.Context, attempt feed.FetchAttempt) error {
+	s.attempts = append(s.attempts, attempt)
+	return nil
+}
+
+func (s *fetchLogStub) FetchHistory(ctx context.Context, urls []string, limit int) (map[string][]feed.FetchAttempt, error) {
+	return nil, nil
+}
+
+func (s *fetchLogStub) DeleteFetchHistory(ctx context.Context, url string) error {
+	return nil
+}
+
+func (s *fetchLogStub) ClearFetchHistory(ctx context.Context) error {
+	return nil
+}
+
+type observerStub struct {
+	attempts    []feed.FetchAttempt
+	servedStale []bool
+}
+
+func (s *observerStub) ObserveFetch(attempt feed.FetchAttempt, servedStale bool) {
+	s.attempts = append(s.attempts, attempt)
+	s.servedStale = append(s.servedStale, servedStale)
+}
+
 func TestExecuteReturnsParsedFeed(t *testing.T) {
 	fetcher := fetcherStub{payload: []byte(sampleFeed)}
 	now := func() time.Time { return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) }
 	store := &storeStub{}
 
-	uc := fetchfeed.New(fetcher, store, now)
+	uc := fetchfeed.New(fetcher, store, nil, nil, fetchfeed.Config{}, now)
 
-	result, err := uc.Execute(context.Background(), "https://example.com/rss")
+	res, err := uc.Execute(context.Background(), "https://example.com/rss")
 	if err != nil {
 		t.Fatalf("Execute() unexpected error: %v", err)
 	}
+	result := res.Feed
 
 	if result.Title != "Example Feed" {
 		t.Errorf("unexpected title: %q", result.Title)
@@ -106,7 +194,7 @@ func TestExecuteReturnsParsedFeed(t *testing.T) {
 }
 
 func TestExecuteValidatesURL(t *testing.T) {
-	uc := fetchfeed.New(fetcherStub{}, &storeStub{}, time.Now)
+	uc := fetchfeed.New(fetcherStub{}, &storeStub{}, nil, nil, fetchfeed.Config{}, time.Now)
 
 	if _, err := uc.Execute(context.Background(), "  "); err == nil {
 		t.Fatal("expected error for empty URL")
@@ -116,7 +204,7 @@ func TestExecuteValidatesURL(t *testing.T) {
 func TestExecutePropagatesFetchError(t *testing.T) {
 	expectedErr := errors.New("network down")
 	fetcher := fetcherStub{err: expectedErr}
-	uc := fetchfeed.New(fetcher, &storeStub{}, time.Now)
+	uc := fetchfeed.New(fetcher, &storeStub{}, nil, nil, fetchfeed.Config{}, time.Now)
 
 	_, err := uc.Execute(context.Background(), "https://example.com/rss")
 	if err == nil || !errors.Is(err, expectedErr) {
@@ -126,13 +214,25 @@ func TestExecutePropagatesFetchError(t *testing.T) {
 
 func TestExecutePropagatesParseError(t *testing.T) {
 	fetcher := fetcherStub{payload: []byte("not xml")}
-	uc := fetchfeed.New(fetcher, &storeStub{}, time.Now)
+	uc := fetchfeed.New(fetcher, &storeStub{}, nil, nil, fetchfeed.Config{}, time.Now)
 
 	if _, err := uc.Execute(context.Background(), "https://example.com/rss"); err == nil {
 		t.Fatal("expected parse error")
 	}
 }
 
+func TestExecuteReportsOversizedBodyAsFetchError(t *testing.T) {
+	tooLarge := &httpclient.BodyTooLargeError{Limit: 16}
+	body := io.MultiReader(strings.NewReader(sampleFeed[:16]), iotest.ErrReader(tooLarge))
+	uc := fetchfeed.New(fetcherStub{body: body}, &storeStub{}, nil, nil, fetchfeed.Config{}, time.Now)
+
+	_, err := uc.Execute(context.Background(), "https://example.com/rss")
+	var target *httpclient.BodyTooLargeError
+	if !errors.As(err, &target) {
+		t.Fatalf("expected body too large error, got %v", err)
+	}
+}
+
 func TestExecuteFallsBackToCacheOnFetchFailure(t *testing.T) {
 	expected := &feed.Feed{
 		SourceURL: "https://example.com/rss",
@@ -142,24 +242,594 @@ func TestExecuteFallsBackToCacheOnFetchFailure(t *testing.T) {
 
 	store := &storeStub{findFeed: expected}
 	fetcher := fetcherStub{err: errors.New("network down")}
-	uc := fetchfeed.New(fetcher, store, time.Now)
+	uc := fetchfeed.New(fetcher, store, nil, nil, fetchfeed.Config{}, time.Now)
 
 	result, err := uc.Execute(context.Background(), expected.SourceURL)
 	if err != nil {
 		t.Fatalf("expected cached feed, got error: %v", err)
 	}
 
-	if result != expected {
+	if result.Feed != expected {
 		t.Fatalf("expected cached feed to be returned")
 	}
+	if !result.Stale || result.UpstreamErr == nil || result.UpstreamErr.Error() != "network down" {
+		t.Errorf("expected the fallback to be marked stale with the upstream error, got %+v", result)
+	}
+	if result.Age < time.Hour {
+		t.Errorf("expected the snapshot age to reach back to its fetch, got %v", result.Age)
+	}
 }
 
 func TestExecuteReturnsErrorWhenStoreFails(t *testing.T) {
 	fetcher := fetcherStub{payload: []byte(sampleFeed)}
 	store := &storeStub{saveErr: errors.New("db down")}
-	uc := fetchfeed.New(fetcher, store, time.Now)
+	uc := fetchfeed.New(fetcher, store, nil, nil, fetchfeed.Config{}, time.Now)
 
 	if _, err := uc.Execute(context.Background(), "https://example.com/rss"); err == nil {
 		t.Fatal("expected error when store fails")
 	}
 }
+
+func TestExecuteSendsStoredValidators(t *testing.T) {
+	var sent feed.Validators
+	store := &storeStub{findFeed: &feed.Feed{
+		SourceURL:    "https://example.com/rss",
+		ETag:         `"v1"`,
+		LastModified: "Mon, 02 Jan 2006 15:04:05 GMT",
+	}}
+	fetcher := fetcherStub{payload: []byte(sampleFeed), etag: `"v2"`, validators: &sent}
+	uc := fetchfeed.New(fetcher, store, nil, nil, fetchfeed.Config{}, time.Now)
+
+	res, err := uc.Execute(context.Background(), "https://example.com/rss")
+	if err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	result := res.Feed
+
+	if sent.ETag != `"v1"` || sent.LastModified != "Mon, 02 Jan 2006 15:04:05 GMT" {
+		t.Errorf("expected stored validators to be sent, got %+v", sent)
+	}
+	if result.ETag != `"v2"` {
+		t.Errorf("expected new etag to be kept, got %q", result.ETag)
+	}
+	if len(store.saved) != 1 || store.saved[0].ETag != `"v2"` {
+		t.Errorf("expected new etag to be persisted")
+	}
+}
+
+func TestRefreshIgnoresValidatorsAndCache(t *testing.T) {
+	sent := feed.Validators{ETag: "unset"}
+	store := &storeStub{findFeed: &feed.Feed{SourceURL: "https://example.com/rss", ETag: `"v1"`}}
+	fetcher := fetcherStub{payload: []byte(sampleFeed), validators: &sent}
+	uc := fetchfeed.New(fetcher, store, nil, nil, fetchfeed.Config{}, time.Now)
+
+	result, err := uc.Refresh(context.Background(), "https://example.com/rss")
+	if err != nil {
+		t.Fatalf("Refresh() unexpected error: %v", err)
+	}
+	if sent != (feed.Validators{}) {
+		t.Errorf("expected an unconditional request, got validators %+v", sent)
+	}
+	if len(result.Items) != 2 || len(store.saved) != 1 {
+		t.Errorf("expected the downloaded feed to be stored, got %d items and %d saves", len(result.Items), len(store.saved))
+	}
+
+	failing := fetchfeed.New(fetcherStub{err: errors.New("network down")}, store, nil, nil, fetchfeed.Config{}, time.Now)
+	if _, err := failing.Refresh(context.Background(), "https://example.com/rss"); err == nil {
+		t.Fatal("expected refresh failures not to fall back to the stored snapshot")
+	}
+}
+
+func TestExecuteServesStoredSnapshotWhenNotModified(t *testing.T) {
+	now := time.Date(2024, 6, 1, 8, 0, 0, 0, time.UTC)
+	cached := &feed.Feed{
+		SourceURL: "https://example.com/rss",
+		Title:     "Cached",
+		ETag:      `"v1"`,
+		FetchedAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+	}
+	store := &storeStub{findFeed: cached}
+	uc := fetchfeed.New(fetcherStub{notModified: true}, store, nil, nil, fetchfeed.Config{}, func() time.Time { return now })
+
+	res, err := uc.Execute(context.Background(), cached.SourceURL)
+	if err != nil {
+		t.Fatalf("expected stored snapshot, got error: %v", err)
+	}
+	result := res.Feed
+
+	if result != cached {
+		t.Fatal("expected stored snapshot to be returned")
+	}
+	if !result.CheckedAt.Equal(now) {
+		t.Errorf("expected checked at to be bumped, got %v", result.CheckedAt)
+	}
+	if len(store.saved) != 0 {
+		t.Errorf("expected snapshot not to be rewritten, got %d saves", len(store.saved))
+	}
+	if len(store.checked) != 1 {
+		t.Errorf("expected checked at to be persisted once, got %d", len(store.checked))
+	}
+}
+
+const richFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:content="http://purl.org/rss/1.0/modules/content/" xmlns:dc="http://purl.org/dc/elements/1.1/">
+  <channel>
+    <title>Podcast</title>
+    <link>https://example.com</link>
+    <category>Tecnologia</category>
+    <image>
+      <url>https://example.com/logo.png</url>
+    </image>
+    <item>
+      <guid isPermaLink="false">episode-1</guid>
+      <title>Episode 1</title>
+      <link>https://example.com/ep1</link>
+      <description>Short summary</description>
+      <content:encoded><![CDATA[<p>Full <strong>show notes</strong></p>]]></content:encoded>
+      <dc:creator>Maria Silva</dc:creator>
+      <category>Go</category>
+      <category> Podcasts </category>
+      <enclosure url="https://example.com/ep1.mp3" length="12345" type="audio/mpeg"/>
+      <enclosure url="https://example.com/ep1.jpg" length="10" type="image/jpeg"/>
+      <pubDate>Mon, 02 Jan 2006 15:04:05 -0700</pubDate>
+    </item>
+  </channel>
+</rss>`
+
+func TestExecuteMapsRichItemFields(t *testing.T) {
+	uc := fetchfeed.New(fetcherStub{payload: []byte(richFeed)}, &storeStub{}, nil, nil, fetchfeed.Config{}, time.Now)
+
+	res, err := uc.Execute(context.Background(), "https://example.com/podcast.xml")
+	if err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	result := res.Feed
+
+	if result.Image != "https://example.com/logo.png" {
+		t.Errorf("unexpected feed image: %q", result.Image)
+	}
+	if len(result.Categories) != 1 || result.Categories[0] != "Tecnologia" {
+		t.Errorf("unexpected feed categories: %v", result.Categories)
+	}
+
+	if len(result.Items) != 1 {
+		t.Fatalf("expected 1 item, got %d", len(result.Items))
+	}
+	item := result.Items[0]
+
+	if item.GUID != "episode-1" {
+		t.Errorf("unexpected guid: %q", item.GUID)
+	}
+	if item.Content != "<p>Full <strong>show notes</strong></p>" {
+		t.Errorf("unexpected content: %q", item.Content)
+	}
+	if len(item.Authors) != 1 || item.Authors[0] != "Maria Silva" {
+		t.Errorf("unexpected authors: %v", item.Authors)
+	}
+	if len(item.Categories) != 2 || item.Categories[1] != "Podcasts" {
+		t.Errorf("unexpected categories: %v", item.Categories)
+	}
+	if len(item.Enclosures) != 2 {
+		t.Fatalf("expected 2 enclosures, got %d", len(item.Enclosures))
+	}
+	if got := item.Enclosures[0]; got.URL != "https://example.com/ep1.mp3" || got.Type != "audio/mpeg" || got.Length != 12345 {
+		t.Errorf("unexpected enclosure: %+v", got)
+	}
+	if item.Image != "https://example.com/ep1.jpg" {
+		t.Errorf("expected image enclosure to be used as item image, got %q", item.Image)
+	}
+}
+
+func TestExecuteClassifiesParseFailure(t *testing.T) {
+	uc := fetchfeed.New(fetcherStub{payload: []byte("not xml")}, &storeStub{}, nil, nil, fetchfeed.Config{}, time.Now)
+
+	if _, err := uc.Execute(context.Background(), "https://example.com/rss"); !errors.Is(err, feed.ErrUnparseableFeed) {
+		t.Fatalf("expected unparseable feed error, got %v", err)
+	}
+}
+
+func TestExecuteServesFreshSnapshotWithoutFetching(t *testing.T) {
+	now := time.Date(2024, 6, 1, 8, 0, 0, 0, time.UTC)
+	calls := &atomic.Int32{}
+	cfg := fetchfeed.Config{MaxAge: 5 * time.Minute}
+
+	cases := []struct {
+		name      string
+		checkedAt time.Time
+		ttl       time.Duration
+		fetches   int32
+	}{
+		{name: "fresh", checkedAt: now.Add(-time.Minute), ttl: 5 * time.Minute, fetches: 0},
+		{name: "stale", checkedAt: now.Add(-10 * time.Minute), ttl: 5 * time.Minute, fetches: 1},
+		{name: "no lifetime", checkedAt: now, ttl: 0, fetches: 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls.Store(0)
+			cached := &feed.Feed{SourceURL: "https://example.com/rss", Title: "Cached", CheckedAt: tc.checkedAt, TTL: tc.ttl}
+			store := &storeStub{findFeed: cached}
+			uc := fetchfeed.New(fetcherStub{payload: []byte(sampleFeed), calls: calls}, store, nil, nil, cfg, func() time.Time { return now })
+
+			if _, err := uc.Execute(context.Background(), cached.SourceURL); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := calls.Load(); got != tc.fetches {
+				t.Errorf("expected %d fetches, got %d", tc.fetches, got)
+			}
+		})
+	}
+}
+
+func TestExecuteIgnoresFreshnessWhenDisabled(t *testing.T) {
+	now := time.Date(2024, 6, 1, 8, 0, 0, 0, time.UTC)
+	calls := &atomic.Int32{}
+	cached := &feed.Feed{SourceURL: "https://example.com/rss", CheckedAt: now, TTL: time.Hour}
+	uc := fetchfeed.New(fetcherStub{payload: []byte(sampleFeed), calls: calls}, &storeStub{findFeed: cached}, nil, nil, fetchfeed.Config{}, func() time.Time { return now })
+
+	res, err := uc.Execute(context.Background(), cached.SourceURL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := res.Feed
+	if calls.Load() != 1 {
+		t.Errorf("expected the feed to be fetched, got %d fetches", calls.Load())
+	}
+	if result.TTL != 0 {
+		t.Errorf("expected no lifetime to be stored, got %v", result.TTL)
+	}
+}
+
+func TestExecuteResolvesSnapshotLifetime(t *testing.T) {
+	channel := func(extra string) string {
+		return `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:sy="http://purl.org/rss/1.0/modules/syndication/">
+  <channel>
+    <title>Example Feed</title>
+    <link>https://example.com</link>` + extra + `
+  </channel>
+</rss>`
+	}
+
+	cases := []struct {
+		name    string
+		fetcher fetcherStub
+		want    time.Duration
+	}{
+		{name: "default", fetcher: fetcherStub{payload: []byte(channel(""))}, want: 5 * time.Minute},
+		{name: "rss ttl", fetcher: fetcherStub{payload: []byte(channel("<ttl>60</ttl>"))}, want: time.Hour},
+		{name: "syndication", fetcher: fetcherStub{payload: []byte(channel("<sy:updatePeriod>hourly</sy:updatePeriod><sy:updateFrequency>2</sy:updateFrequency>"))}, want: 30 * time.Minute},
+		{name: "capped", fetcher: fetcherStub{payload: []byte(channel("<sy:updatePeriod>weekly</sy:updatePeriod>"))}, want: 24 * time.Hour},
+		{name: "cache control wins", fetcher: fetcherStub{payload: []byte(channel("<ttl>60</ttl>")), maxAge: 2 * time.Minute, hasMaxAge: true}, want: 2 * time.Minute},
+		{name: "no-cache", fetcher: fetcherStub{payload: []byte(channel("<ttl>60</ttl>")), hasMaxAge: true}, want: 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := fetchfeed.New(tc.fetcher, &storeStub{}, nil, nil, fetchfeed.Config{MaxAge: 5 * time.Minute}, time.Now)
+
+			res, err := uc.Execute(context.Background(), "https://example.com/rss")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			result := res.Feed
+			if result.TTL != tc.want {
+				t.Errorf("expected lifetime %v, got %v", tc.want, result.TTL)
+			}
+		})
+	}
+}
+
+func TestExecuteRenewsLifetimeWhenNotModified(t *testing.T) {
+	now := time.Date(2024, 6, 1, 8, 0, 0, 0, time.UTC)
+	cached := &feed.Feed{SourceURL: "https://example.com/rss", ETag: `"v1"`, CheckedAt: now.Add(-time.Hour), TTL: 20 * time.Minute}
+	store := &storeStub{findFeed: cached}
+	uc := fetchfeed.New(fetcherStub{notModified: true}, store, nil, nil, fetchfeed.Config{MaxAge: 5 * time.Minute}, func() time.Time { return now })
+
+	res, err := uc.Execute(context.Background(), cached.SourceURL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := res.Feed
+	if result.TTL != 20*time.Minute || len(store.ttls) != 1 || store.ttls[0] != 20*time.Minute {
+		t.Errorf("expected stored lifetime to be kept, got %v (persisted %v)", result.TTL, store.ttls)
+	}
+	if !result.FreshAt(now.Add(10 * time.Minute)) {
+		t.Error("expected revalidated snapshot to be fresh again")
+	}
+}
+
+func TestExecuteCoalescesConcurrentFetches(t *testing.T) {
+	calls := &atomic.Int32{}
+	release := make(chan struct{})
+	uc := fetchfeed.New(fetcherStub{payload: []byte(sampleFeed), calls: calls, release: release}, &storeStub{}, nil, nil, fetchfeed.Config{}, time.Now)
+
+	const callers = 5
+	results := make([]*fetchfeed.Result, callers)
+	var wg sync.WaitGroup
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := uc.Execute(context.Background(), "https://example.com/rss")
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			results[i] = result
+		}()
+	}
+
+	// Give every caller the chance to join the flight before the download completes.
+	for calls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("expected a single fetch, got %d", got)
+	}
+	for _, result := range results {
+		if result != results[0] {
+			t.Fatal("expected callers to share the same snapshot")
+		}
+	}
+}
+
+func TestExecuteCallerGivingUpDoesNotCancelSharedFetch(t *testing.T) {
+	calls := &atomic.Int32{}
+	release := make(chan struct{})
+	store := &storeStub{}
+	uc := fetchfeed.New(fetcherStub{payload: []byte(sampleFeed), calls: calls, release: release}, store, nil, nil, fetchfeed.Config{}, time.Now)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	impatient := make(chan error, 1)
+	go func() {
+		_, err := uc.Execute(ctx, "https://example.com/rss")
+		impatient <- err
+	}()
+	for calls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	patient := make(chan error, 1)
+	go func() {
+		_, err := uc.Execute(context.Background(), "https://example.com/rss")
+		patient <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	cancel()
+	if err := <-impatient; !errors.Is(err, feed.ErrUpstreamTimeout) || !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the cancelled caller to get an upstream timeout with its own error, got %v", err)
+	}
+
+	close(release)
+	if err := <-patient; err != nil {
+		t.Fatalf("expected shared fetch to complete, got %v", err)
+	}
+	if len(store.saved) != 1 {
+		t.Errorf("expected snapshot to be saved once, got %d", len(store.saved))
+	}
+}
+
+func TestExecuteSharedFetchOutlivesFirstCallerDeadline(t *testing.T) {
+	calls := &atomic.Int32{}
+	release := make(chan struct{})
+	uc := fetchfeed.New(fetcherStub{payload: []byte(sampleFeed), calls: calls, release: release}, &storeStub{}, nil, nil, fetchfeed.Config{}, time.Now)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	hurried := make(chan error, 1)
+	go func() {
+		_, err := uc.Execute(ctx, "https://example.com/rss")
+		hurried <- err
+	}()
+	for calls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	patient := make(chan error, 1)
+	go func() {
+		_, err := uc.Execute(context.Background(), "https://example.com/rss")
+		patient <- err
+	}()
+
+	if err := <-hurried; !errors.Is(err, feed.ErrUpstreamTimeout) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the first caller to hit its own deadline as an upstream timeout, got %v", err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	if err := <-patient; err != nil {
+		t.Fatalf("expected the shared fetch to outlive the first caller's deadline, got %v", err)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected a single shared fetch, got %d", got)
+	}
+}
+
+func TestExecuteServesStoredSnapshotWhenCallerDeadlineEndsFirst(t *testing.T) {
+	cached := &feed.Feed{
+		SourceURL: "https://example.com/rss",
+		Title:     "Cached",
+		FetchedAt: time.Now().Add(-time.Hour),
+	}
+	calls := &atomic.Int32{}
+	release := make(chan struct{})
+	defer close(release)
+	uc := fetchfeed.New(fetcherStub{payload: []byte(sampleFeed), calls: calls, release: release}, &storeStub{findFeed: cached}, nil, nil, fetchfeed.Config{}, time.Now)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	result, err := uc.Execute(ctx, cached.SourceURL)
+	if err != nil {
+		t.Fatalf("expected the stored snapshot, got error: %v", err)
+	}
+	if result.Feed != cached || !result.Stale {
+		t.Fatalf("expected the stored snapshot marked stale, got %+v", result)
+	}
+	if !errors.Is(result.UpstreamErr, feed.ErrUpstreamTimeout) {
+		t.Errorf("expected an upstream timeout as the upstream error, got %v", result.UpstreamErr)
+	}
+	if result.Age < time.Hour {
+		t.Errorf("expected the snapshot age to reach back to its fetch, got %v", result.Age)
+	}
+}
+
+func TestExecuteRecordsFetchAttempts(t *testing.T) {
+	const url = "https://example.com/rss"
+	start := time.Date(2024, 6, 1, 8, 0, 0, 0, time.UTC)
+	var ticks int
+	clock := func() time.Time {
+		ticks++
+		return start.Add(time.Duration(ticks) * time.Second)
+	}
+	cached := &feed.Feed{
+		SourceURL: url,
+		Items:     []feed.Item{{Link: "https://example.com/item1"}},
+	}
+
+	cases := []struct {
+		name    string
+		fetcher fetcherStub
+		want    feed.FetchAttempt
+	}{
+		{
+			name:    "success",
+			fetcher: fetcherStub{payload: []byte(sampleFeed)},
+			want:    feed.FetchAttempt{StatusCode: 200, Bytes: int64(len(sampleFeed)), ItemDelta: 1},
+		},
+		{
+			name:    "not modified",
+			fetcher: fetcherStub{notModified: true},
+			want:    feed.FetchAttempt{StatusCode: 304},
+		},
+		{
+			name:    "upstream status",
+			fetcher: fetcherStub{err: &feed.UpstreamStatusError{StatusCode: 503}},
+			want:    feed.FetchAttempt{StatusCode: 503, ErrorClass: feed.FetchErrorStatus, Error: "upstream returned status 503"},
+		},
+		{
+			name:    "unparseable",
+			fetcher: fetcherStub{payload: []byte("not xml")},
+			want:    feed.FetchAttempt{StatusCode: 200, Bytes: 7, ErrorClass: feed.FetchErrorUnparseable},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fetchLog := &fetchLogStub{}
+			uc := fetchfeed.New(tc.fetcher, &storeStub{findFeed: cached}, fetchLog, nil, fetchfeed.Config{}, clock)
+			_, _ = uc.Execute(context.Background(), url)
+
+			if len(fetchLog.attempts) != 1 {
+				t.Fatalf("expected one recorded attempt, got %d", len(fetchLog.attempts))
+			}
+			got := fetchLog.attempts[0]
+			if got.SourceURL != url || got.AttemptedAt.IsZero() || got.Duration <= 0 {
+				t.Errorf("unexpected attempt metadata %+v", got)
+			}
+			if tc.want.Error == "" {
+				got.Error = ""
+			}
+			got.SourceURL, got.AttemptedAt, got.Duration = "", time.Time{}, 0
+			if got != tc.want {
+				t.Errorf("expected %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestExecuteReportsAttemptsToObserver(t *testing.T) {
+	const url = "https://example.com/rss"
+	cached := &feed.Feed{SourceURL: url}
+
+	observer := &observerStub{}
+	ok := fetchfeed.New(fetcherStub{payload: []byte(sampleFeed)}, &storeStub{findFeed: cached}, nil, observer, fetchfeed.Config{}, time.Now)
+	failing := fetchfeed.New(fetcherStub{err: feed.ErrUpstreamUnreachable}, &storeStub{findFeed: cached}, nil, observer, fetchfeed.Config{}, time.Now)
+	for _, uc := range []*fetchfeed.UseCase{ok, failing} {
+		if _, err := uc.Execute(context.Background(), url); err != nil {
+			t.Fatalf("Execute() unexpected error: %v", err)
+		}
+	}
+
+	if len(observer.attempts) != 2 {
+		t.Fatalf("expected two observed attempts, got %d", len(observer.attempts))
+	}
+	if got := observer.attempts[0]; got.Failed() || got.Bytes != int64(len(sampleFeed)) || observer.servedStale[0] {
+		t.Errorf("expected a successful download, got %+v stale=%v", got, observer.servedStale[0])
+	}
+	if got := observer.attempts[1]; got.ErrorClass != feed.FetchErrorUnreachable || !observer.servedStale[1] {
+		t.Errorf("expected an unreachable attempt served stale, got %+v stale=%v", got, observer.servedStale[1])
+	}
+}
+
+func TestExecuteRecordsOnlyStoredFeeds(t *testing.T) {
+	const url = "https://example.com/rss"
+	observer := &observerStub{}
+
+	cases := []struct {
+		name    string
+		fetcher fetcherStub
+		cached  *feed.Feed
+		record  bool
+	}{
+		{name: "first download", fetcher: fetcherStub{payload: []byte(sampleFeed)}, record: true},
+		{name: "unknown feed failing", fetcher: fetcherStub{err: feed.ErrUpstreamUnreachable}},
+		{name: "stored feed failing", fetcher: fetcherStub{err: feed.ErrUpstreamUnreachable}, cached: &feed.Feed{SourceURL: url}, record: true},
+		{name: "rejected url", fetcher: fetcherStub{err: fmt.Errorf("%w: private address", feed.ErrInvalidInput)}, cached: &feed.Feed{SourceURL: url}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fetchLog := &fetchLogStub{}
+			uc := fetchfeed.New(tc.fetcher, &storeStub{findFeed: tc.cached}, fetchLog, observer, fetchfeed.Config{}, time.Now)
+			_, _ = uc.Execute(context.Background(), url)
+
+			if recorded := len(fetchLog.attempts) == 1; recorded != tc.record {
+				t.Fatalf("expected recorded=%v, got %+v", tc.record, fetchLog.attempts)
+			}
+		})
+	}
+	if len(observer.attempts) != len(cases) {
+		t.Fatalf("expected every attempt to be observed, got %d", len(observer.attempts))
+	}
+}
+
+func TestExecuteDoesNotRecordFreshSnapshots(t *testing.T) {
+	now := time.Date(2024, 6, 1, 8, 0, 0, 0, time.UTC)
+	cached := &feed.Feed{SourceURL: "https://example.com/rss", CheckedAt: now, TTL: time.Hour}
+	fetchLog := &fetchLogStub{}
+	uc := fetchfeed.New(fetcherStub{payload: []byte(sampleFeed)}, &storeStub{findFeed: cached}, fetchLog, nil, fetchfeed.Config{MaxAge: time.Hour}, func() time.Time { return now })
+
+	if _, err := uc.Execute(context.Background(), cached.SourceURL); err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	if len(fetchLog.attempts) != 0 {
+		t.Fatalf("expected no attempt for a fresh snapshot, got %+v", fetchLog.attempts)
+	}
+}
+
+func TestExecuteReportsSnapshotAge(t *testing.T) {
+	now := time.Date(2024, 6, 1, 8, 0, 0, 0, time.UTC)
+	cached := &feed.Feed{SourceURL: "https://example.com/rss", CheckedAt: now.Add(-2 * time.Minute), TTL: time.Hour}
+	clock := func() time.Time { return now }
+
+	served, err := fetchfeed.New(fetcherStub{payload: []byte(sampleFeed)}, &storeStub{findFeed: cached}, nil, nil, fetchfeed.Config{MaxAge: time.Hour}, clock).
+		Execute(context.Background(), cached.SourceURL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if served.Stale || served.Age != 2*time.Minute {
+		t.Errorf("expected a fresh snapshot aged 2m, got stale=%v age=%v", served.Stale, served.Age)
+	}
+
+	downloaded, err := fetchfeed.New(fetcherStub{payload: []byte(sampleFeed)}, &storeStub{findFeed: cached}, nil, nil, fetchfeed.Config{}, clock).
+		Execute(context.Background(), cached.SourceURL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if downloaded.Stale || downloaded.Age != 0 || downloaded.UpstreamErr != nil {
+		t.Errorf("expected a downloaded feed to be current, got %+v", downloaded)
+	}
+}
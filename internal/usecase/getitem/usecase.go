@@ -0,0 +1,43 @@
+package getitem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// ErrNotFound is returned when no stored item matches the identifier.
+var ErrNotFound = fmt.Errorf("item %w", feed.ErrNotFound)
+
+// UseCase retrieves a single stored item.
+type UseCase struct {
+	store repository.ItemStore
+}
+
+// New constructs the use case with the required dependencies.
+func New(store repository.ItemStore) *UseCase {
+	return &UseCase{store: store}
+}
+
+// Execute returns the item with the given identifier.
+func (uc *UseCase) Execute(ctx context.Context, id int64) (*feed.Item, error) {
+	if uc.store == nil {
+		return nil, errors.New("item store not configured")
+	}
+	if id <= 0 {
+		return nil, fmt.Errorf("%w: invalid item id", feed.ErrInvalidInput)
+	}
+
+	item, err := uc.store.FindItem(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find item: %w: %w", feed.ErrStorage, err)
+	}
+	if item == nil {
+		return nil, ErrNotFound
+	}
+
+	return item, nil
+}
@@ -0,0 +1,64 @@
+package getitem_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+	feedRepo "rssreader/internal/infra/feed"
+	"rssreader/internal/usecase/getitem"
+)
+
+type storeStub struct {
+	err error
+}
+
+func (s storeStub) ListItems(ctx context.Context, sourceURL string, limit, offset int) ([]feed.Item, error) {
+	return nil, nil
+}
+
+func (s storeStub) FindItem(ctx context.Context, id int64) (*feed.Item, error) {
+	return nil, s.err
+}
+
+func TestExecuteReturnsItem(t *testing.T) {
+	store := feedRepo.NewMemoryStore()
+	entry := &feed.Feed{SourceURL: "https://example.com/rss", Items: []feed.Item{{GUID: "1", Title: "Stored"}}}
+	if err := store.Save(context.Background(), entry); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	result, err := getitem.New(store).Execute(context.Background(), entry.Items[0].ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Title != "Stored" || result.FeedURL != "https://example.com/rss" {
+		t.Fatalf("expected stored item to be returned, got %+v", result)
+	}
+}
+
+func TestExecuteReturnsNotFound(t *testing.T) {
+	_, err := getitem.New(feedRepo.NewMemoryStore()).Execute(context.Background(), 7)
+	if !errors.Is(err, getitem.ErrNotFound) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestExecuteValidatesID(t *testing.T) {
+	if _, err := getitem.New(storeStub{}).Execute(context.Background(), 0); err == nil {
+		t.Fatal("expected error for invalid id")
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	if _, err := getitem.New(nil).Execute(context.Background(), 1); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
+
+func TestExecutePropagatesStoreError(t *testing.T) {
+	if _, err := getitem.New(storeStub{err: errors.New("db error")}).Execute(context.Background(), 1); err == nil {
+		t.Fatal("expected error when store fails")
+	}
+}
@@ -0,0 +1,106 @@
+package importsubscriptions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// Reasons reported for outlines that were not imported.
+const (
+	ReasonInvalidURL        = "invalid url"
+	ReasonDuplicate         = "duplicate in document"
+	ReasonAlreadySubscribed = "already subscribed"
+)
+
+// Report summarises the outcome of an import.
+type Report struct {
+	Imported []feed.Subscription
+	Skipped  []Skipped
+}
+
+// Skipped describes an outline that was not imported and why.
+type Skipped struct {
+	URL    string
+	Title  string
+	Reason string
+}
+
+// UseCase subscribes to every feed of an exported feed list, such as an OPML document.
+type UseCase struct {
+	store    repository.SubscriptionStore
+	interval time.Duration
+	clock    func() time.Time
+}
+
+// New constructs the use case; imported feeds are polled every interval.
+func New(store repository.SubscriptionStore, interval time.Duration, clock func() time.Time) *UseCase {
+	if clock == nil {
+		clock = time.Now
+	}
+	return &UseCase{store: store, interval: interval, clock: clock}
+}
+
+// Execute subscribes to the outlines, skipping invalid entries and feeds that are already subscribed.
+func (uc *UseCase) Execute(ctx context.Context, outlines []feed.Outline) (*Report, error) {
+	if uc.store == nil {
+		return nil, errors.New("subscription store not configured")
+	}
+	if uc.interval <= 0 {
+		return nil, errors.New("import interval not configured")
+	}
+
+	existing, err := uc.store.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list subscriptions: %w: %w", feed.ErrStorage, err)
+	}
+
+	seen := make(map[string]bool, len(existing)+len(outlines))
+	for _, sub := range existing {
+		seen[sub.SourceURL] = false
+	}
+
+	report := &Report{}
+	now := uc.clock()
+	for _, outline := range outlines {
+		sourceURL, err := feed.NormalizeURL(outline.FeedURL)
+		if err != nil {
+			report.skip(outline, strings.TrimSpace(outline.FeedURL), ReasonInvalidURL)
+			continue
+		}
+
+		if imported, ok := seen[sourceURL]; ok {
+			reason := ReasonAlreadySubscribed
+			if imported {
+				reason = ReasonDuplicate
+			}
+			report.skip(outline, sourceURL, reason)
+			continue
+		}
+
+		entry := &feed.Subscription{
+			SourceURL:  sourceURL,
+			Title:      strings.TrimSpace(outline.Title),
+			Folder:     strings.TrimSpace(outline.Folder),
+			Interval:   uc.interval,
+			NextPollAt: now,
+		}
+		if err := uc.store.Subscribe(ctx, entry); err != nil {
+			return nil, fmt.Errorf("subscribe %s: %w: %w", sourceURL, feed.ErrStorage, err)
+		}
+
+		seen[sourceURL] = true
+		report.Imported = append(report.Imported, *entry)
+	}
+
+	return report, nil
+}
+
+func (r *Report) skip(outline feed.Outline, url, reason string) {
+	r.Skipped = append(r.Skipped, Skipped{URL: url, Title: strings.TrimSpace(outline.Title), Reason: reason})
+}
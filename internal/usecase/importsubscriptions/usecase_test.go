@@ -0,0 +1,103 @@
+package importsubscriptions_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/usecase/importsubscriptions"
+)
+
+type storeStub struct {
+	existing     []feed.Subscription
+	saved        []feed.Subscription
+	listErr      error
+	subscribeErr error
+}
+
+func (s *storeStub) Subscribe(ctx context.Context, entry *feed.Subscription) error {
+	if s.subscribeErr != nil {
+		return s.subscribeErr
+	}
+	entry.ID = int64(len(s.saved) + 1)
+	s.saved = append(s.saved, *entry)
+	return nil
+}
+
+func (s *storeStub) Unsubscribe(ctx context.Context, url string) error {
+	return nil
+}
+
+func (s *storeStub) List(ctx context.Context) ([]feed.Subscription, error) {
+	return s.existing, s.listErr
+}
+
+func (s *storeStub) ListDue(ctx context.Context, now time.Time, limit int) ([]feed.Subscription, error) {
+	return nil, nil
+}
+
+func (s *storeStub) MarkPolled(ctx context.Context, id int64, polledAt time.Time, pollErr error) error {
+	return nil
+}
+
+func TestExecuteImportsAndReportsSkipped(t *testing.T) {
+	store := &storeStub{existing: []feed.Subscription{{ID: 9, SourceURL: "https://old.example.com/rss"}}}
+	uc := importsubscriptions.New(store, time.Hour, nil)
+
+	report, err := uc.Execute(context.Background(), []feed.Outline{
+		{Title: "News", FeedURL: "https://news.example.com/rss", Folder: "Notícias"},
+		{Title: "Old", FeedURL: "https://old.example.com/rss"},
+		{Title: "News again", FeedURL: " https://news.example.com/rss "},
+		{Title: "Broken", FeedURL: "not a url"},
+		{Title: "Tech", FeedURL: "https://tech.example.com/atom.xml", Folder: "Tecnologia/Go"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(report.Imported) != 2 {
+		t.Fatalf("expected 2 imported feeds, got %d", len(report.Imported))
+	}
+	if got := store.saved[0]; got.Folder != "Notícias" || got.Title != "News" || got.Interval != time.Hour {
+		t.Errorf("unexpected stored subscription: %+v", got)
+	}
+	if got := store.saved[1].Folder; got != "Tecnologia/Go" {
+		t.Errorf("expected nested folder path to be kept, got %q", got)
+	}
+
+	expectedReasons := []string{
+		importsubscriptions.ReasonAlreadySubscribed,
+		importsubscriptions.ReasonDuplicate,
+		importsubscriptions.ReasonInvalidURL,
+	}
+	if len(report.Skipped) != len(expectedReasons) {
+		t.Fatalf("expected %d skipped entries, got %+v", len(expectedReasons), report.Skipped)
+	}
+	for i, reason := range expectedReasons {
+		if report.Skipped[i].Reason != reason {
+			t.Errorf("skipped[%d]: expected reason %q, got %q", i, reason, report.Skipped[i].Reason)
+		}
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	if _, err := importsubscriptions.New(nil, time.Hour, nil).Execute(context.Background(), nil); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
+
+func TestExecutePropagatesStoreErrors(t *testing.T) {
+	outlines := []feed.Outline{{FeedURL: "https://example.com/rss"}}
+
+	listFails := importsubscriptions.New(&storeStub{listErr: errors.New("db error")}, time.Hour, nil)
+	if _, err := listFails.Execute(context.Background(), outlines); err == nil {
+		t.Fatal("expected error when listing fails")
+	}
+
+	subscribeFails := importsubscriptions.New(&storeStub{subscribeErr: errors.New("db error")}, time.Hour, nil)
+	if _, err := subscribeFails.Execute(context.Background(), outlines); err == nil {
+		t.Fatal("expected error when subscribing fails")
+	}
+}
@@ -0,0 +1,34 @@
+package listcatalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// UseCase lists the curated catalog of suggested sources.
+type UseCase struct {
+	store repository.CatalogStore
+}
+
+// New constructs the use case with its dependencies.
+func New(store repository.CatalogStore) *UseCase {
+	return &UseCase{store: store}
+}
+
+// Execute returns the catalog grouped by category. Readers only see enabled sources;
+// includeDisabled lists every source for administration.
+func (uc *UseCase) Execute(ctx context.Context, includeDisabled bool) ([]feed.CatalogCategory, error) {
+	if uc.store == nil {
+		return nil, errors.New("catalog store not configured")
+	}
+
+	categories, err := uc.store.ListCatalog(ctx, includeDisabled)
+	if err != nil {
+		return nil, fmt.Errorf("list catalog: %w: %w", feed.ErrStorage, err)
+	}
+	return categories, nil
+}
@@ -0,0 +1,66 @@
+package listcatalog_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+	feedRepo "rssreader/internal/infra/feed"
+	"rssreader/internal/repository"
+	"rssreader/internal/usecase/listcatalog"
+)
+
+func TestExecuteHidesDisabledSources(t *testing.T) {
+	ctx := context.Background()
+	store := feedRepo.NewMemoryCatalogStore()
+	err := store.SeedCatalog(ctx, []feed.CatalogCategory{
+		{ID: "noticias", Name: "Notícias", Sources: []feed.CatalogSource{
+			{Name: "G1", URL: "https://g1.example/rss"},
+			{Name: "UOL", URL: "https://uol.example/rss"},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+	if _, err := store.SetCatalogSourceDisabled(ctx, 1, true); err != nil {
+		t.Fatalf("disable: %v", err)
+	}
+	uc := listcatalog.New(store)
+
+	categories, err := uc.Execute(ctx, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(categories) != 1 || len(categories[0].Sources) != 1 || categories[0].Sources[0].Name != "UOL" {
+		t.Fatalf("expected only the enabled source, got %+v", categories)
+	}
+
+	categories, err = uc.Execute(ctx, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(categories[0].Sources) != 2 || !categories[0].Sources[0].Disabled {
+		t.Fatalf("expected disabled sources for administration, got %+v", categories)
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	if _, err := listcatalog.New(nil).Execute(context.Background(), false); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
+
+func TestExecuteWrapsStoreErrors(t *testing.T) {
+	if _, err := listcatalog.New(failingStore{}).Execute(context.Background(), false); !errors.Is(err, feed.ErrStorage) {
+		t.Fatalf("expected storage error, got %v", err)
+	}
+}
+
+type failingStore struct {
+	repository.CatalogStore
+}
+
+func (failingStore) ListCatalog(ctx context.Context, includeDisabled bool) ([]feed.CatalogCategory, error) {
+	return nil, errors.New("db down")
+}
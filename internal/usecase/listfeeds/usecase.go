@@ -4,34 +4,128 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"rssreader/internal/domain/feed"
 	"rssreader/internal/repository"
 )
 
+// Page size limits for the feed listing.
+const (
+	DefaultLimit = 10
+	MaxLimit     = 100
+)
+
+// Query selects a page of stored feeds. Sort is feed.SortFetchedAt (the default),
+// feed.SortTitle or feed.SortUnread; a cursor is only valid for the sort it came from.
+// FolderID and Tag restrict the listing to a server-side folder or a tag.
+type Query struct {
+	Sort     string
+	Cursor   string
+	FolderID int64
+	Tag      string
+	Limit    int
+}
+
+// Page is a slice of the stored feeds; NextCursor is empty on the last page.
+type Page struct {
+	Feeds      []feed.Summary
+	NextCursor string
+}
+
 // UseCase retrieves recent feed snapshots from storage.
 type UseCase struct {
-	store repository.FeedStore
+	store    repository.FeedStore
+	fetchLog repository.FetchLogStore
 }
 
-// New constructs the use case with the required dependencies.
-func New(store repository.FeedStore) *UseCase {
-	return &UseCase{store: store}
+// New constructs the use case with the required dependencies. Without a fetchLog the
+// summaries carry no health status.
+func New(store repository.FeedStore, fetchLog repository.FetchLogStore) *UseCase {
+	return &UseCase{store: store, fetchLog: fetchLog}
 }
 
-// Execute returns a limited list of recent feeds.
-func (uc *UseCase) Execute(ctx context.Context, limit int) ([]feed.Summary, error) {
+// Execute returns the page of feeds following query.Cursor.
+func (uc *UseCase) Execute(ctx context.Context, query Query) (*Page, error) {
 	if uc.store == nil {
 		return nil, errors.New("feed store not configured")
 	}
-	if limit <= 0 {
-		limit = 10
+
+	sort := strings.TrimSpace(query.Sort)
+	switch sort {
+	case "":
+		sort = feed.SortFetchedAt
+	case feed.SortFetchedAt, feed.SortTitle, feed.SortUnread:
+	default:
+		return nil, fmt.Errorf("%w: unknown sort %q", feed.ErrInvalidInput, query.Sort)
 	}
 
-	feeds, err := uc.store.ListRecent(ctx, limit)
+	after, err := feed.ParseSummaryCursor(query.Cursor)
 	if err != nil {
-		return nil, fmt.Errorf("list feeds: %w", err)
+		return nil, err
+	}
+	if !after.IsZero() && after.Sort != sort {
+		return nil, fmt.Errorf("%w: cursor does not match sort %q", feed.ErrInvalidInput, sort)
+	}
+
+	if query.FolderID < 0 {
+		return nil, fmt.Errorf("%w: invalid folder id", feed.ErrInvalidInput)
+	}
+	var tag string
+	if strings.TrimSpace(query.Tag) != "" {
+		if tag, err = feed.NormalizeTag(query.Tag); err != nil {
+			return nil, err
+		}
 	}
 
-	return feeds, nil
+	limit := query.Limit
+	switch {
+	case limit <= 0:
+		limit = DefaultLimit
+	case limit > MaxLimit:
+		limit = MaxLimit
+	}
+
+	// Ask for one extra feed to learn whether another page follows.
+	feeds, err := uc.store.ListRecent(ctx, feed.RecentQuery{
+		Sort:     sort,
+		After:    after,
+		FolderID: query.FolderID,
+		Tag:      tag,
+		Limit:    limit + 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list feeds: %w: %w", feed.ErrStorage, err)
+	}
+
+	page := &Page{Feeds: feeds}
+	if len(feeds) > limit {
+		page.Feeds = feeds[:limit]
+		page.NextCursor = feed.SummaryCursorFor(sort, page.Feeds[limit-1]).Encode()
+	}
+	if err := uc.attachHealth(ctx, page.Feeds); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// attachHealth derives the health of each feed from its latest fetch attempts.
+func (uc *UseCase) attachHealth(ctx context.Context, feeds []feed.Summary) error {
+	if uc.fetchLog == nil || len(feeds) == 0 {
+		return nil
+	}
+
+	urls := make([]string, 0, len(feeds))
+	for _, summary := range feeds {
+		urls = append(urls, summary.SourceURL)
+	}
+	history, err := uc.fetchLog.FetchHistory(ctx, urls, feed.HealthWindow)
+	if err != nil {
+		return fmt.Errorf("fetch history: %w: %w", feed.ErrStorage, err)
+	}
+
+	for i := range feeds {
+		feeds[i].Health = feed.HealthOf(history[feeds[i].SourceURL])
+	}
+	return nil
 }
@@ -3,65 +3,231 @@ package listfeeds_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	"rssreader/internal/domain/feed"
+	feedRepo "rssreader/internal/infra/feed"
 	"rssreader/internal/usecase/listfeeds"
 )
 
 type storeStub struct {
-	feeds []feed.Summary
-	err   error
+	err error
 }
 
 func (s storeStub) Save(ctx context.Context, entry *feed.Feed) error {
 	return nil
 }
 
-func (s storeStub) ListRecent(ctx context.Context, limit int) ([]feed.Summary, error) {
-	return s.feeds, s.err
+func (s storeStub) ListRecent(ctx context.Context, query feed.RecentQuery) ([]feed.Summary, error) {
+	return nil, s.err
 }
 
 func (s storeStub) FindByURL(ctx context.Context, url string) (*feed.Feed, error) {
 	return nil, nil
 }
 
+func (s storeStub) MarkChecked(ctx context.Context, url string, checkedAt time.Time, ttl time.Duration) error {
+	return nil
+}
+
+func (s storeStub) LookupURL(ctx context.Context, id int64) (string, error) {
+	return "", nil
+}
+
+func (s storeStub) Delete(ctx context.Context, url string) (bool, error) {
+	return false, nil
+}
+
 func (s storeStub) Clear(ctx context.Context) error {
 	return nil
 }
 
 func TestExecuteReturnsSummaries(t *testing.T) {
-	expected := []feed.Summary{
-		{
-			SourceURL: "https://example.com",
-			Title:     "Example",
-			FetchedAt: time.Now(),
-		},
+	store := feedRepo.NewMemoryStore()
+	for _, url := range []string{"https://example.com/a.xml", "https://example.com/b.xml"} {
+		if err := store.Save(context.Background(), &feed.Feed{SourceURL: url, Title: "Example"}); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+	}
+
+	usecase := listfeeds.New(store, nil)
+
+	result, err := usecase.Execute(context.Background(), listfeeds.Query{Limit: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Feeds) != 1 {
+		t.Fatalf("expected limit to be honoured, got %d entries", len(result.Feeds))
+	}
+	if result.NextCursor == "" {
+		t.Fatal("expected a cursor for the remaining feed")
+	}
+}
+
+func TestExecutePaginatesEachSort(t *testing.T) {
+	store := feedRepo.NewMemoryStore()
+	base := time.Date(2024, time.May, 10, 12, 0, 0, 0, time.UTC)
+	for i, title := range []string{"banana", "Cereja", "abacaxi", "Damasco", "amora"} {
+		entry := &feed.Feed{
+			SourceURL: fmt.Sprintf("https://example.com/%d.xml", i),
+			Title:     title,
+			FetchedAt: base.Add(time.Duration(i) * time.Minute),
+		}
+		for j := 0; j < i%3; j++ {
+			entry.Items = append(entry.Items, feed.Item{GUID: fmt.Sprintf("%d-%d", i, j), Title: "Item"})
+		}
+		if err := store.Save(context.Background(), entry); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+	}
+
+	cases := map[string][]string{
+		"":              {"amora", "Damasco", "abacaxi", "Cereja", "banana"},
+		feed.SortTitle:  {"abacaxi", "amora", "banana", "Cereja", "Damasco"},
+		feed.SortUnread: {"abacaxi", "amora", "Cereja", "Damasco", "banana"},
+	}
+	for sort, want := range cases {
+		uc := listfeeds.New(store, nil)
+		var (
+			titles []string
+			cursor string
+		)
+		for pages := 0; ; pages++ {
+			if pages > 5 {
+				t.Fatalf("sort %q: pagination did not terminate", sort)
+			}
+			page, err := uc.Execute(context.Background(), listfeeds.Query{Sort: sort, Cursor: cursor, Limit: 2})
+			if err != nil {
+				t.Fatalf("sort %q: unexpected error: %v", sort, err)
+			}
+			for _, summary := range page.Feeds {
+				titles = append(titles, summary.Title)
+			}
+			if page.NextCursor == "" {
+				break
+			}
+			cursor = page.NextCursor
+		}
+		if !reflect.DeepEqual(titles, want) {
+			t.Errorf("sort %q: expected %v, got %v", sort, want, titles)
+		}
+	}
+}
+
+func TestExecuteScopesToFolderAndTag(t *testing.T) {
+	ctx := context.Background()
+	store := feedRepo.NewMemoryStore()
+	for _, url := range []string{"https://example.com/a.xml", "https://example.com/b.xml"} {
+		if err := store.Save(ctx, &feed.Feed{SourceURL: url, Title: url, Items: []feed.Item{{GUID: "1"}}}); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+	}
+	folder, _ := store.CreateFolder(ctx, "Tech")
+	if _, err := store.MoveFeed(ctx, 1, folder.ID); err != nil {
+		t.Fatalf("move: %v", err)
+	}
+	if _, err := store.SetFeedTags(ctx, 2, []string{"go"}); err != nil {
+		t.Fatalf("tag: %v", err)
+	}
+	uc := listfeeds.New(store, nil)
+
+	for query, want := range map[listfeeds.Query]string{
+		{FolderID: folder.ID}: "https://example.com/a.xml",
+		{Tag: " GO "}:         "https://example.com/b.xml",
+	} {
+		page, err := uc.Execute(ctx, query)
+		if err != nil {
+			t.Fatalf("%+v: unexpected error: %v", query, err)
+		}
+		if len(page.Feeds) != 1 || page.Feeds[0].SourceURL != want || page.Feeds[0].UnreadCount != 1 {
+			t.Errorf("%+v: expected only %s, got %+v", query, want, page.Feeds)
+		}
+	}
+}
+
+func TestExecuteAttachesHealth(t *testing.T) {
+	ctx := context.Background()
+	store := feedRepo.NewMemoryStore()
+	fetchLog := feedRepo.NewMemoryFetchLogStore()
+	outcomes := map[string][]string{
+		"https://example.com/healthy.xml":  {"", ""},
+		"https://example.com/degraded.xml": {"", feed.FetchErrorTimeout},
+		"https://example.com/failing.xml":  {"", feed.FetchErrorStatus, feed.FetchErrorStatus, feed.FetchErrorStatus},
+		"https://example.com/unknown.xml":  nil,
+	}
+	for url, classes := range outcomes {
+		if err := store.Save(ctx, &feed.Feed{SourceURL: url, Title: url}); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+		for _, class := range classes {
+			if err := fetchLog.RecordFetch(ctx, feed.FetchAttempt{SourceURL: url, ErrorClass: class}); err != nil {
+				t.Fatalf("record fetch: %v", err)
+			}
+		}
 	}
 
-	usecase := listfeeds.New(storeStub{feeds: expected})
+	page, err := listfeeds.New(store, fetchLog).Execute(ctx, listfeeds.Query{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]string{
+		"https://example.com/healthy.xml":  feed.HealthHealthy,
+		"https://example.com/degraded.xml": feed.HealthDegraded,
+		"https://example.com/failing.xml":  feed.HealthFailing,
+		"https://example.com/unknown.xml":  "",
+	}
+	got := make(map[string]string)
+	for _, summary := range page.Feeds {
+		got[summary.SourceURL] = summary.Health
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected health %v, got %v", want, got)
+	}
+}
+
+func TestExecuteRejectsInvalidQueries(t *testing.T) {
+	store := feedRepo.NewMemoryStore()
+	for _, url := range []string{"https://example.com/a.xml", "https://example.com/b.xml"} {
+		if err := store.Save(context.Background(), &feed.Feed{SourceURL: url, Title: "Example"}); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+	}
+	uc := listfeeds.New(store, nil)
 
-	result, err := usecase.Execute(context.Background(), 5)
+	page, err := uc.Execute(context.Background(), listfeeds.Query{Limit: 1})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	if len(result) != len(expected) {
-		t.Fatalf("expected %d entries, got %d", len(expected), len(result))
+	for name, query := range map[string]listfeeds.Query{
+		"unknown sort":  {Sort: "popularity"},
+		"malformed":     {Cursor: "not a cursor"},
+		"sort mismatch": {Sort: feed.SortTitle, Cursor: page.NextCursor},
+		"folder":        {FolderID: -1},
+		"tag":           {Tag: strings.Repeat("x", feed.MaxTagLength+1)},
+	} {
+		if _, err := uc.Execute(context.Background(), query); !errors.Is(err, feed.ErrInvalidInput) {
+			t.Errorf("%s: expected invalid input, got %v", name, err)
+		}
 	}
 }
 
 func TestExecuteRequiresStore(t *testing.T) {
-	usecase := listfeeds.New(nil)
-	if _, err := usecase.Execute(context.Background(), 5); err == nil {
+	usecase := listfeeds.New(nil, nil)
+	if _, err := usecase.Execute(context.Background(), listfeeds.Query{}); err == nil {
 		t.Fatal("expected error when store is nil")
 	}
 }
 
 func TestExecutePropagatesStoreError(t *testing.T) {
-	usecase := listfeeds.New(storeStub{err: errors.New("db error")})
-	if _, err := usecase.Execute(context.Background(), 5); err == nil {
+	usecase := listfeeds.New(storeStub{err: errors.New("db error")}, nil)
+	if _, err := usecase.Execute(context.Background(), listfeeds.Query{}); err == nil {
 		t.Fatal("expected error when store fails")
 	}
 }
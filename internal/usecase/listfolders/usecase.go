@@ -0,0 +1,33 @@
+package listfolders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// UseCase lists the server-side folders with their feed and unread counts.
+type UseCase struct {
+	store repository.FolderStore
+}
+
+// New constructs the use case with its dependencies.
+func New(store repository.FolderStore) *UseCase {
+	return &UseCase{store: store}
+}
+
+// Execute returns every folder ordered by name.
+func (uc *UseCase) Execute(ctx context.Context) ([]feed.Folder, error) {
+	if uc.store == nil {
+		return nil, errors.New("folder store not configured")
+	}
+
+	folders, err := uc.store.ListFolders(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list folders: %w: %w", feed.ErrStorage, err)
+	}
+	return folders, nil
+}
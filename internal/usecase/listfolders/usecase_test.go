@@ -0,0 +1,42 @@
+package listfolders_test
+
+import (
+	"context"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+	feedRepo "rssreader/internal/infra/feed"
+	"rssreader/internal/usecase/listfolders"
+)
+
+func TestExecuteReturnsFoldersWithCounts(t *testing.T) {
+	ctx := context.Background()
+	store := feedRepo.NewMemoryStore()
+	if err := store.Save(ctx, &feed.Feed{SourceURL: "https://example.com/rss", Items: []feed.Item{{GUID: "1"}, {GUID: "2"}}}); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	tech, _ := store.CreateFolder(ctx, "Tech")
+	if _, err := store.CreateFolder(ctx, "News"); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if _, err := store.MoveFeed(ctx, 1, tech.ID); err != nil {
+		t.Fatalf("move: %v", err)
+	}
+
+	folders, err := listfolders.New(store).Execute(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(folders) != 2 || folders[0].Name != "News" || folders[1].Name != "Tech" {
+		t.Fatalf("expected folders ordered by name, got %+v", folders)
+	}
+	if folders[1].FeedCount != 1 || folders[1].UnreadCount != 2 {
+		t.Fatalf("expected counts for the Tech folder, got %+v", folders[1])
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	if _, err := listfolders.New(nil).Execute(context.Background()); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
@@ -0,0 +1,28 @@
+package listhosts
+
+import (
+	"context"
+	"errors"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// UseCase reports the circuit breaker state of publisher hosts.
+type UseCase struct {
+	reader repository.HostStatusReader
+}
+
+// New constructs the use case with the required dependencies.
+func New(reader repository.HostStatusReader) *UseCase {
+	return &UseCase{reader: reader}
+}
+
+// Execute returns the hosts the fetcher has recently seen failing.
+func (uc *UseCase) Execute(ctx context.Context) ([]feed.HostStatus, error) {
+	if uc.reader == nil {
+		return nil, errors.New("host status reader not configured")
+	}
+
+	return uc.reader.HostStatuses(), nil
+}
@@ -0,0 +1,35 @@
+package listhosts_test
+
+import (
+	"context"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/usecase/listhosts"
+)
+
+type readerStub struct {
+	statuses []feed.HostStatus
+}
+
+func (r readerStub) HostStatuses() []feed.HostStatus {
+	return r.statuses
+}
+
+func TestExecuteReturnsHostStatuses(t *testing.T) {
+	expected := []feed.HostStatus{{Host: "example.com", State: feed.HostStateOpen, ConsecutiveFailures: 5}}
+
+	result, err := listhosts.New(readerStub{statuses: expected}).Execute(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 1 || result[0].Host != "example.com" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestExecuteRequiresReader(t *testing.T) {
+	if _, err := listhosts.New(nil).Execute(context.Background()); err == nil {
+		t.Fatal("expected error when reader is nil")
+	}
+}
@@ -0,0 +1,55 @@
+package listitems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// UseCase pages through the items stored for a feed.
+type UseCase struct {
+	store repository.ItemStore
+}
+
+// New constructs the use case with the required dependencies.
+func New(store repository.ItemStore) *UseCase {
+	return &UseCase{store: store}
+}
+
+// Execute returns up to limit items of the feed, skipping the first offset ones.
+func (uc *UseCase) Execute(ctx context.Context, url string, limit, offset int) ([]feed.Item, error) {
+	if uc.store == nil {
+		return nil, errors.New("item store not configured")
+	}
+
+	trimmedURL := strings.TrimSpace(url)
+	if trimmedURL == "" {
+		return nil, fmt.Errorf("%w: url is required", feed.ErrInvalidInput)
+	}
+
+	switch {
+	case limit <= 0:
+		limit = defaultLimit
+	case limit > maxLimit:
+		limit = maxLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	items, err := uc.store.ListItems(ctx, trimmedURL, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("list items: %w: %w", feed.ErrStorage, err)
+	}
+
+	return items, nil
+}
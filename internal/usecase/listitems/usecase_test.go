@@ -0,0 +1,70 @@
+package listitems_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/usecase/listitems"
+)
+
+type storeStub struct {
+	items  []feed.Item
+	err    error
+	limit  int
+	offset int
+}
+
+func (s *storeStub) ListItems(ctx context.Context, sourceURL string, limit, offset int) ([]feed.Item, error) {
+	s.limit, s.offset = limit, offset
+	return s.items, s.err
+}
+
+func (s *storeStub) FindItem(ctx context.Context, id int64) (*feed.Item, error) {
+	return nil, nil
+}
+
+func TestExecuteReturnsItems(t *testing.T) {
+	store := &storeStub{items: []feed.Item{{ID: 1, Title: "First"}}}
+
+	result, err := listitems.New(store).Execute(context.Background(), "https://example.com/rss", 0, -5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 1 {
+		t.Fatalf("expected 1 item, got %d", len(result))
+	}
+	if store.limit != 20 || store.offset != 0 {
+		t.Errorf("expected default paging, got limit=%d offset=%d", store.limit, store.offset)
+	}
+}
+
+func TestExecuteCapsLimit(t *testing.T) {
+	store := &storeStub{}
+	if _, err := listitems.New(store).Execute(context.Background(), "https://example.com/rss", 1000, 40); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if store.limit != 100 || store.offset != 40 {
+		t.Errorf("expected capped limit, got limit=%d offset=%d", store.limit, store.offset)
+	}
+}
+
+func TestExecuteValidatesURL(t *testing.T) {
+	if _, err := listitems.New(&storeStub{}).Execute(context.Background(), " ", 10, 0); err == nil {
+		t.Fatal("expected error for empty URL")
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	if _, err := listitems.New(nil).Execute(context.Background(), "https://example.com/rss", 10, 0); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
+
+func TestExecutePropagatesStoreError(t *testing.T) {
+	uc := listitems.New(&storeStub{err: errors.New("db error")})
+	if _, err := uc.Execute(context.Background(), "https://example.com/rss", 10, 0); err == nil {
+		t.Fatal("expected error when store fails")
+	}
+}
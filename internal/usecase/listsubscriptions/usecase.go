@@ -0,0 +1,34 @@
+package listsubscriptions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// UseCase retrieves the feeds currently polled in the background.
+type UseCase struct {
+	store repository.SubscriptionStore
+}
+
+// New constructs the use case with the required dependencies.
+func New(store repository.SubscriptionStore) *UseCase {
+	return &UseCase{store: store}
+}
+
+// Execute returns every subscription.
+func (uc *UseCase) Execute(ctx context.Context) ([]feed.Subscription, error) {
+	if uc.store == nil {
+		return nil, errors.New("subscription store not configured")
+	}
+
+	subs, err := uc.store.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list subscriptions: %w: %w", feed.ErrStorage, err)
+	}
+
+	return subs, nil
+}
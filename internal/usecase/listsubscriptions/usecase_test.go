@@ -0,0 +1,62 @@
+package listsubscriptions_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/usecase/listsubscriptions"
+)
+
+type storeStub struct {
+	subs []feed.Subscription
+	err  error
+}
+
+func (s storeStub) Subscribe(ctx context.Context, entry *feed.Subscription) error {
+	return nil
+}
+
+func (s storeStub) Unsubscribe(ctx context.Context, url string) error {
+	return nil
+}
+
+func (s storeStub) List(ctx context.Context) ([]feed.Subscription, error) {
+	return s.subs, s.err
+}
+
+func (s storeStub) ListDue(ctx context.Context, now time.Time, limit int) ([]feed.Subscription, error) {
+	return nil, nil
+}
+
+func (s storeStub) MarkPolled(ctx context.Context, id int64, polledAt time.Time, pollErr error) error {
+	return nil
+}
+
+func TestExecuteReturnsSubscriptions(t *testing.T) {
+	expected := []feed.Subscription{
+		{ID: 1, SourceURL: "https://example.com/rss", Interval: time.Hour},
+	}
+
+	result, err := listsubscriptions.New(storeStub{subs: expected}).Execute(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != len(expected) {
+		t.Fatalf("expected %d entries, got %d", len(expected), len(result))
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	if _, err := listsubscriptions.New(nil).Execute(context.Background()); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
+
+func TestExecutePropagatesStoreError(t *testing.T) {
+	if _, err := listsubscriptions.New(storeStub{err: errors.New("db error")}).Execute(context.Background()); err == nil {
+		t.Fatal("expected error when store fails")
+	}
+}
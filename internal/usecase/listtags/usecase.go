@@ -0,0 +1,33 @@
+package listtags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// UseCase lists the tags in use on stored feeds.
+type UseCase struct {
+	store repository.TagStore
+}
+
+// New constructs the use case with its dependencies.
+func New(store repository.TagStore) *UseCase {
+	return &UseCase{store: store}
+}
+
+// Execute returns every tag with the number of feeds carrying it, ordered by tag.
+func (uc *UseCase) Execute(ctx context.Context) ([]feed.TagCount, error) {
+	if uc.store == nil {
+		return nil, errors.New("tag store not configured")
+	}
+
+	tags, err := uc.store.ListTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list tags: %w: %w", feed.ErrStorage, err)
+	}
+	return tags, nil
+}
@@ -0,0 +1,43 @@
+package listtags_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+	feedRepo "rssreader/internal/infra/feed"
+	"rssreader/internal/usecase/listtags"
+)
+
+func TestExecuteCountsFeedsPerTag(t *testing.T) {
+	ctx := context.Background()
+	store := feedRepo.NewMemoryStore()
+	for i, url := range []string{"https://a.example/rss", "https://b.example/rss"} {
+		if err := store.Save(ctx, &feed.Feed{SourceURL: url}); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+		tags := []string{"go"}
+		if i == 0 {
+			tags = append(tags, "news")
+		}
+		if _, err := store.SetFeedTags(ctx, int64(i+1), tags); err != nil {
+			t.Fatalf("tag: %v", err)
+		}
+	}
+
+	tags, err := listtags.New(store).Execute(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []feed.TagCount{{Tag: "go", FeedCount: 2}, {Tag: "news", FeedCount: 1}}
+	if !reflect.DeepEqual(tags, want) {
+		t.Fatalf("expected %+v, got %+v", want, tags)
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	if _, err := listtags.New(nil).Execute(context.Background()); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
@@ -0,0 +1,185 @@
+package listtimeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// Page size limits for the timeline.
+const (
+	DefaultLimit = 50
+	MaxLimit     = 200
+)
+
+// Query filters the timeline. Feed, Folder, FolderID and Tag are mutually exclusive.
+// Folder is a subscription folder path and also includes nested folders; FolderID
+// is a server-side folder.
+type Query struct {
+	Feed     string
+	Folder   string
+	FolderID int64
+	Tag      string
+	Read     *bool
+	Starred  *bool
+	From     time.Time
+	To       time.Time
+	Cursor   string
+	Limit    int
+}
+
+// Page is a slice of the timeline; NextCursor is empty on the last page.
+type Page struct {
+	Items      []feed.Item
+	NextCursor string
+}
+
+// UseCase lists the items of every stored feed as a single timeline.
+type UseCase struct {
+	timeline      repository.TimelineStore
+	subscriptions repository.SubscriptionStore
+	folders       repository.FolderStore
+	tags          repository.TagStore
+}
+
+// New constructs the use case. Folder paths require subscriptions, folder ids
+// require folders and tag filters require tags.
+func New(timeline repository.TimelineStore, subscriptions repository.SubscriptionStore, folders repository.FolderStore, tags repository.TagStore) *UseCase {
+	return &UseCase{timeline: timeline, subscriptions: subscriptions, folders: folders, tags: tags}
+}
+
+// Execute returns the page of items following query.Cursor, newest first.
+func (uc *UseCase) Execute(ctx context.Context, query Query) (*Page, error) {
+	if uc.timeline == nil {
+		return nil, errors.New("timeline store not configured")
+	}
+
+	after, err := feed.ParseCursor(query.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	if !query.From.IsZero() && !query.To.IsZero() && !query.From.Before(query.To) {
+		return nil, fmt.Errorf("%w: timeline date range is empty", feed.ErrInvalidInput)
+	}
+
+	limit := query.Limit
+	switch {
+	case limit <= 0:
+		limit = DefaultLimit
+	case limit > MaxLimit:
+		limit = MaxLimit
+	}
+
+	feedURLs, err := uc.feedURLs(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ask for one extra item to learn whether another page follows.
+	items, err := uc.timeline.Timeline(ctx, feed.TimelineQuery{
+		FeedURLs: feedURLs,
+		Read:     query.Read,
+		Starred:  query.Starred,
+		From:     query.From,
+		To:       query.To,
+		After:    after,
+		Limit:    limit + 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list timeline: %w: %w", feed.ErrStorage, err)
+	}
+
+	page := &Page{Items: items}
+	if len(items) > limit {
+		page.Items = items[:limit]
+		last := page.Items[limit-1]
+		page.NextCursor = feed.Cursor{Time: last.PublishedAt, ID: last.ID}.Encode()
+	}
+	return page, nil
+}
+
+// feedURLs resolves the feed, folder and tag filters; nil means every feed.
+func (uc *UseCase) feedURLs(ctx context.Context, query Query) ([]string, error) {
+	feedURL := strings.TrimSpace(query.Feed)
+	folder := strings.Trim(strings.TrimSpace(query.Folder), "/")
+	tag := strings.TrimSpace(query.Tag)
+
+	scopes := 0
+	for _, set := range []bool{feedURL != "", folder != "", query.FolderID != 0, tag != ""} {
+		if set {
+			scopes++
+		}
+	}
+	switch {
+	case scopes > 1:
+		return nil, fmt.Errorf("%w: feed, folder, folderId and tag are mutually exclusive", feed.ErrInvalidInput)
+	case feedURL != "":
+		return []string{feedURL}, nil
+	case folder != "":
+		return uc.folderPathURLs(ctx, folder)
+	case query.FolderID != 0:
+		return uc.folderURLs(ctx, query.FolderID)
+	case tag != "":
+		return uc.tagURLs(ctx, tag)
+	default:
+		return nil, nil
+	}
+}
+
+func (uc *UseCase) folderPathURLs(ctx context.Context, folder string) ([]string, error) {
+	if uc.subscriptions == nil {
+		return nil, errors.New("subscription store not configured")
+	}
+
+	subs, err := uc.subscriptions.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list subscriptions: %w: %w", feed.ErrStorage, err)
+	}
+
+	urls := []string{}
+	for _, sub := range subs {
+		if sub.Folder == folder || strings.HasPrefix(sub.Folder, folder+"/") {
+			urls = append(urls, sub.SourceURL)
+		}
+	}
+	return urls, nil
+}
+
+func (uc *UseCase) folderURLs(ctx context.Context, id int64) ([]string, error) {
+	if uc.folders == nil {
+		return nil, errors.New("folder store not configured")
+	}
+
+	urls, err := uc.folders.FolderFeedURLs(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("list folder feeds: %w: %w", feed.ErrStorage, err)
+	}
+	if urls == nil {
+		return nil, fmt.Errorf("folder %w", feed.ErrNotFound)
+	}
+	return urls, nil
+}
+
+func (uc *UseCase) tagURLs(ctx context.Context, raw string) ([]string, error) {
+	if uc.tags == nil {
+		return nil, errors.New("tag store not configured")
+	}
+
+	tag, err := feed.NormalizeTag(raw)
+	if err != nil {
+		return nil, err
+	}
+	urls, err := uc.tags.TagFeedURLs(ctx, tag)
+	if err != nil {
+		return nil, fmt.Errorf("list tagged feeds: %w: %w", feed.ErrStorage, err)
+	}
+	if urls == nil {
+		urls = []string{}
+	}
+	return urls, nil
+}
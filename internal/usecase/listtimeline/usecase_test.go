@@ -0,0 +1,162 @@
+package listtimeline_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	feedRepo "rssreader/internal/infra/feed"
+	"rssreader/internal/usecase/listtimeline"
+)
+
+var base = time.Date(2024, time.May, 10, 12, 0, 0, 0, time.UTC)
+
+func seed(t *testing.T) (*feedRepo.MemoryStore, *feedRepo.MemorySubscriptionStore) {
+	t.Helper()
+	ctx := context.Background()
+	feeds := feedRepo.NewMemoryStore()
+	subs := feedRepo.NewMemorySubscriptionStore()
+
+	for i, folder := range []string{"Notícias", "Notícias/Tecnologia", "Esportes"} {
+		url := fmt.Sprintf("https://%d.example/rss", i)
+		entry := &feed.Feed{SourceURL: url, FetchedAt: base}
+		for j := 0; j < 3; j++ {
+			entry.Items = append(entry.Items, feed.Item{
+				GUID:        fmt.Sprintf("%d-%d", i, j),
+				Title:       fmt.Sprintf("%d-%d", i, j),
+				Link:        fmt.Sprintf("https://%d.example/%d", i, j),
+				PublishedAt: base.Add(time.Duration(3*j+i) * time.Minute),
+			})
+		}
+		if err := feeds.Save(ctx, entry); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+		if err := subs.Subscribe(ctx, &feed.Subscription{SourceURL: url, Folder: folder, Interval: time.Hour}); err != nil {
+			t.Fatalf("subscribe: %v", err)
+		}
+	}
+	return feeds, subs
+}
+
+func TestExecutePaginatesWithCursor(t *testing.T) {
+	feeds, subs := seed(t)
+	uc := listtimeline.New(feeds, subs, feeds, feeds)
+
+	var (
+		seen   []string
+		cursor string
+	)
+	for pages := 0; ; pages++ {
+		if pages > 5 {
+			t.Fatal("pagination did not terminate")
+		}
+		page, err := uc.Execute(context.Background(), listtimeline.Query{Cursor: cursor, Limit: 4})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, item := range page.Items {
+			seen = append(seen, item.Title)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+
+	if len(seen) != 9 || seen[0] != "2-2" || seen[8] != "0-0" {
+		t.Fatalf("expected all 9 items newest first, got %v", seen)
+	}
+}
+
+func TestExecuteFiltersByFolder(t *testing.T) {
+	feeds, subs := seed(t)
+
+	page, err := listtimeline.New(feeds, subs, feeds, feeds).Execute(context.Background(), listtimeline.Query{Folder: "Notícias"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Items) != 6 {
+		t.Fatalf("expected items of the folder and its subfolder, got %d", len(page.Items))
+	}
+	for _, item := range page.Items {
+		if item.FeedURL == "https://2.example/rss" {
+			t.Fatalf("unexpected item from another folder: %+v", item)
+		}
+	}
+
+	empty, err := listtimeline.New(feeds, subs, feeds, feeds).Execute(context.Background(), listtimeline.Query{Folder: "Música"})
+	if err != nil || len(empty.Items) != 0 {
+		t.Fatalf("expected unknown folder to be empty, got %+v, %v", empty, err)
+	}
+}
+
+func TestExecuteFiltersByServerFolderAndTag(t *testing.T) {
+	ctx := context.Background()
+	feeds, subs := seed(t)
+	folder, err := feeds.CreateFolder(ctx, "Leitura")
+	if err != nil {
+		t.Fatalf("create folder: %v", err)
+	}
+	if _, err := feeds.MoveFeed(ctx, 1, folder.ID); err != nil {
+		t.Fatalf("move: %v", err)
+	}
+	if _, err := feeds.SetFeedTags(ctx, 3, []string{"esportes"}); err != nil {
+		t.Fatalf("tag: %v", err)
+	}
+	uc := listtimeline.New(feeds, subs, feeds, feeds)
+
+	cases := []struct {
+		query listtimeline.Query
+		feed  string
+	}{
+		{query: listtimeline.Query{FolderID: folder.ID}, feed: "https://0.example/rss"},
+		{query: listtimeline.Query{Tag: " Esportes "}, feed: "https://2.example/rss"},
+	}
+	for _, tc := range cases {
+		page, err := uc.Execute(ctx, tc.query)
+		if err != nil {
+			t.Fatalf("%+v: unexpected error: %v", tc.query, err)
+		}
+		if len(page.Items) != 3 {
+			t.Fatalf("%+v: expected the 3 items of %s, got %d", tc.query, tc.feed, len(page.Items))
+		}
+		for _, item := range page.Items {
+			if item.FeedURL != tc.feed {
+				t.Fatalf("%+v: unexpected item from %s", tc.query, item.FeedURL)
+			}
+		}
+	}
+
+	if _, err := uc.Execute(ctx, listtimeline.Query{FolderID: 99}); !errors.Is(err, feed.ErrNotFound) {
+		t.Errorf("expected not found for an unknown folder, got %v", err)
+	}
+	if page, err := uc.Execute(ctx, listtimeline.Query{Tag: "unused"}); err != nil || len(page.Items) != 0 {
+		t.Errorf("expected an unused tag to be empty, got %+v, %v", page, err)
+	}
+}
+
+func TestExecuteValidatesQuery(t *testing.T) {
+	uc := listtimeline.New(feedRepo.NewMemoryStore(), nil, nil, nil)
+
+	queries := []listtimeline.Query{
+		{Cursor: "not a cursor"},
+		{Feed: "https://0.example/rss", Folder: "Notícias"},
+		{Folder: "Notícias", FolderID: 1},
+		{FolderID: 1, Tag: "go"},
+		{From: base, To: base},
+	}
+	for _, query := range queries {
+		if _, err := uc.Execute(context.Background(), query); !errors.Is(err, feed.ErrInvalidInput) {
+			t.Errorf("%+v: expected invalid input, got %v", query, err)
+		}
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	if _, err := listtimeline.New(nil, nil, nil, nil).Execute(context.Background(), listtimeline.Query{}); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
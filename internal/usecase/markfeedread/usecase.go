@@ -0,0 +1,42 @@
+package markfeedread
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// UseCase marks every unread item of a feed as read.
+type UseCase struct {
+	store repository.ItemStateStore
+}
+
+// New constructs the use case with its dependencies.
+func New(store repository.ItemStateStore) *UseCase {
+	return &UseCase{store: store}
+}
+
+// Execute marks the feed items as read, limited to items published before olderThan
+// when it is not zero, and returns how many items changed.
+func (uc *UseCase) Execute(ctx context.Context, url string, olderThan time.Time) (int64, error) {
+	if uc.store == nil {
+		return 0, errors.New("item store not configured")
+	}
+
+	trimmedURL := strings.TrimSpace(url)
+	if trimmedURL == "" {
+		return 0, fmt.Errorf("%w: url is required", feed.ErrInvalidInput)
+	}
+
+	updated, err := uc.store.MarkFeedRead(ctx, trimmedURL, olderThan)
+	if err != nil {
+		return 0, fmt.Errorf("mark feed read: %w: %w", feed.ErrStorage, err)
+	}
+
+	return updated, nil
+}
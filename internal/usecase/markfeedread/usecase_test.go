@@ -0,0 +1,62 @@
+package markfeedread_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/usecase/markfeedread"
+)
+
+type storeStub struct {
+	updated   int64
+	err       error
+	url       string
+	olderThan time.Time
+}
+
+func (s *storeStub) UpdateItemState(ctx context.Context, id int64, change feed.StateChange) (*feed.Item, error) {
+	return nil, nil
+}
+
+func (s *storeStub) MarkFeedRead(ctx context.Context, sourceURL string, olderThan time.Time) (int64, error) {
+	s.url, s.olderThan = sourceURL, olderThan
+	return s.updated, s.err
+}
+
+func TestExecuteMarksFeedRead(t *testing.T) {
+	cutoff := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := &storeStub{updated: 4}
+
+	updated, err := markfeedread.New(store).Execute(context.Background(), " https://example.com/rss ", cutoff)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated != 4 {
+		t.Errorf("expected 4 updated items, got %d", updated)
+	}
+	if store.url != "https://example.com/rss" || !store.olderThan.Equal(cutoff) {
+		t.Errorf("unexpected store arguments: %q %v", store.url, store.olderThan)
+	}
+}
+
+func TestExecuteValidatesURL(t *testing.T) {
+	if _, err := markfeedread.New(&storeStub{}).Execute(context.Background(), "", time.Time{}); err == nil {
+		t.Fatal("expected error for empty URL")
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	if _, err := markfeedread.New(nil).Execute(context.Background(), "https://example.com/rss", time.Time{}); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
+
+func TestExecutePropagatesStoreError(t *testing.T) {
+	uc := markfeedread.New(&storeStub{err: errors.New("db error")})
+	if _, err := uc.Execute(context.Background(), "https://example.com/rss", time.Time{}); err == nil {
+		t.Fatal("expected error when store fails")
+	}
+}
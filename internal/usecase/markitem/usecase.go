@@ -0,0 +1,46 @@
+package markitem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// ErrNotFound is returned when no stored item matches the identifier.
+var ErrNotFound = fmt.Errorf("item %w", feed.ErrNotFound)
+
+// UseCase updates the read, starred and archived flags of a single item.
+type UseCase struct {
+	store repository.ItemStateStore
+}
+
+// New constructs the use case with its dependencies.
+func New(store repository.ItemStateStore) *UseCase {
+	return &UseCase{store: store}
+}
+
+// Execute applies the state change to the item and returns the updated item.
+func (uc *UseCase) Execute(ctx context.Context, id int64, change feed.StateChange) (*feed.Item, error) {
+	if uc.store == nil {
+		return nil, errors.New("item store not configured")
+	}
+	if id <= 0 {
+		return nil, fmt.Errorf("%w: invalid item id", feed.ErrInvalidInput)
+	}
+	if change.IsEmpty() {
+		return nil, fmt.Errorf("%w: at least one of read, starred or archived is required", feed.ErrInvalidInput)
+	}
+
+	item, err := uc.store.UpdateItemState(ctx, id, change)
+	if err != nil {
+		return nil, fmt.Errorf("update item state: %w: %w", feed.ErrStorage, err)
+	}
+	if item == nil {
+		return nil, ErrNotFound
+	}
+
+	return item, nil
+}
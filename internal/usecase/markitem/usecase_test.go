@@ -0,0 +1,75 @@
+package markitem_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/usecase/markitem"
+)
+
+type storeStub struct {
+	item    *feed.Item
+	err     error
+	changes []feed.StateChange
+}
+
+func (s *storeStub) UpdateItemState(ctx context.Context, id int64, change feed.StateChange) (*feed.Item, error) {
+	s.changes = append(s.changes, change)
+	return s.item, s.err
+}
+
+func (s *storeStub) MarkFeedRead(ctx context.Context, sourceURL string, olderThan time.Time) (int64, error) {
+	return 0, nil
+}
+
+func TestExecuteUpdatesState(t *testing.T) {
+	read := true
+	store := &storeStub{item: &feed.Item{ID: 3, Read: true}}
+
+	item, err := markitem.New(store).Execute(context.Background(), 3, feed.StateChange{Read: &read})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !item.Read {
+		t.Error("expected updated item to be returned")
+	}
+	if len(store.changes) != 1 || store.changes[0].Read == nil || store.changes[0].Starred != nil {
+		t.Fatalf("unexpected changes forwarded to store: %+v", store.changes)
+	}
+}
+
+func TestExecuteRejectsEmptyChange(t *testing.T) {
+	store := &storeStub{}
+	if _, err := markitem.New(store).Execute(context.Background(), 3, feed.StateChange{}); err == nil {
+		t.Fatal("expected error for empty change")
+	}
+	if len(store.changes) != 0 {
+		t.Fatal("expected store not to be called")
+	}
+}
+
+func TestExecuteReturnsNotFound(t *testing.T) {
+	starred := true
+	_, err := markitem.New(&storeStub{}).Execute(context.Background(), 3, feed.StateChange{Starred: &starred})
+	if !errors.Is(err, markitem.ErrNotFound) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	read := true
+	if _, err := markitem.New(nil).Execute(context.Background(), 1, feed.StateChange{Read: &read}); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
+
+func TestExecutePropagatesStoreError(t *testing.T) {
+	read := true
+	uc := markitem.New(&storeStub{err: errors.New("db error")})
+	if _, err := uc.Execute(context.Background(), 1, feed.StateChange{Read: &read}); err == nil {
+		t.Fatal("expected error when store fails")
+	}
+}
@@ -0,0 +1,42 @@
+package movefeed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// ErrNotFound is returned when the feed or the target folder does not exist.
+var ErrNotFound = fmt.Errorf("feed or folder %w", feed.ErrNotFound)
+
+// UseCase files stored feeds into server-side folders.
+type UseCase struct {
+	store repository.FolderStore
+}
+
+// New constructs the use case with its dependencies.
+func New(store repository.FolderStore) *UseCase {
+	return &UseCase{store: store}
+}
+
+// Execute files the feed in the folder, or unfiles it when folderID is zero.
+func (uc *UseCase) Execute(ctx context.Context, feedID, folderID int64) error {
+	if uc.store == nil {
+		return errors.New("folder store not configured")
+	}
+	if folderID < 0 {
+		return fmt.Errorf("%w: invalid folder id", feed.ErrInvalidInput)
+	}
+
+	moved, err := uc.store.MoveFeed(ctx, feedID, folderID)
+	if err != nil {
+		return fmt.Errorf("move feed: %w: %w", feed.ErrStorage, err)
+	}
+	if !moved {
+		return ErrNotFound
+	}
+	return nil
+}
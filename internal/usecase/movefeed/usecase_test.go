@@ -0,0 +1,73 @@
+package movefeed_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+	feedRepo "rssreader/internal/infra/feed"
+	"rssreader/internal/usecase/movefeed"
+)
+
+func TestExecuteMovesFeedBetweenFolders(t *testing.T) {
+	ctx := context.Background()
+	store := feedRepo.NewMemoryStore()
+	if err := store.Save(ctx, &feed.Feed{SourceURL: "https://example.com/rss"}); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	news, _ := store.CreateFolder(ctx, "News")
+	tech, _ := store.CreateFolder(ctx, "Tech")
+	uc := movefeed.New(store)
+
+	if err := uc.Execute(ctx, 1, news.ID); err != nil {
+		t.Fatalf("move to news: %v", err)
+	}
+	if err := uc.Execute(ctx, 1, tech.ID); err != nil {
+		t.Fatalf("move to tech: %v", err)
+	}
+
+	for folder, want := range map[int64][]string{news.ID: {}, tech.ID: {"https://example.com/rss"}} {
+		urls, err := store.FolderFeedURLs(ctx, folder)
+		if err != nil {
+			t.Fatalf("folder feeds: %v", err)
+		}
+		if !reflect.DeepEqual(urls, want) {
+			t.Errorf("folder %d: expected %v, got %v", folder, want, urls)
+		}
+	}
+
+	if err := uc.Execute(ctx, 1, 0); err != nil {
+		t.Fatalf("unfile: %v", err)
+	}
+	if urls, _ := store.FolderFeedURLs(ctx, tech.ID); len(urls) != 0 {
+		t.Fatalf("expected the feed to be unfiled, got %v", urls)
+	}
+}
+
+func TestExecuteReportsMissingFeedOrFolder(t *testing.T) {
+	ctx := context.Background()
+	store := feedRepo.NewMemoryStore()
+	if err := store.Save(ctx, &feed.Feed{SourceURL: "https://example.com/rss"}); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	folder, _ := store.CreateFolder(ctx, "News")
+	uc := movefeed.New(store)
+
+	if err := uc.Execute(ctx, 99, folder.ID); !errors.Is(err, feed.ErrNotFound) {
+		t.Errorf("expected not found for an unknown feed, got %v", err)
+	}
+	if err := uc.Execute(ctx, 1, 99); !errors.Is(err, feed.ErrNotFound) {
+		t.Errorf("expected not found for an unknown folder, got %v", err)
+	}
+	if err := uc.Execute(ctx, 1, -1); !errors.Is(err, feed.ErrInvalidInput) {
+		t.Errorf("expected invalid input for a negative folder id, got %v", err)
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	if err := movefeed.New(nil).Execute(context.Background(), 1, 1); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
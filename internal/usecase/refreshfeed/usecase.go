@@ -0,0 +1,98 @@
+package refreshfeed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// ErrNotFound is returned when no stored feed has the requested id.
+var ErrNotFound = fmt.Errorf("feed %w", feed.ErrNotFound)
+
+// Refresher downloads a feed unconditionally and stores the new snapshot.
+type Refresher interface {
+	Refresh(ctx context.Context, url string) (*feed.Feed, error)
+}
+
+// Result describes a refreshed feed and how its items changed compared with the
+// previously stored snapshot.
+type Result struct {
+	Feed    *feed.Feed
+	Added   []feed.Item
+	Updated []feed.Item
+}
+
+// UseCase forces a stored feed to be downloaded again.
+type UseCase struct {
+	store     repository.FeedStore
+	refresher Refresher
+}
+
+// New constructs the use case with its dependencies.
+func New(store repository.FeedStore, refresher Refresher) *UseCase {
+	return &UseCase{store: store, refresher: refresher}
+}
+
+// Execute refreshes the feed with the given id, bypassing cache validators.
+func (uc *UseCase) Execute(ctx context.Context, id int64) (*Result, error) {
+	if uc.store == nil {
+		return nil, errors.New("feed store not configured")
+	}
+	if uc.refresher == nil {
+		return nil, errors.New("feed refresher not configured")
+	}
+	if id <= 0 {
+		return nil, fmt.Errorf("%w: invalid feed id", feed.ErrInvalidInput)
+	}
+
+	url, err := uc.store.LookupURL(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("lookup feed: %w: %w", feed.ErrStorage, err)
+	}
+	if url == "" {
+		return nil, ErrNotFound
+	}
+
+	previous, err := uc.store.FindByURL(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("find feed: %w: %w", feed.ErrStorage, err)
+	}
+	known := make(map[string]feed.Item)
+	if previous != nil {
+		for _, item := range previous.Items {
+			known[item.Key()] = item
+		}
+	}
+
+	refreshed, err := uc.refresher.Refresh(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{Feed: refreshed}
+	for _, item := range refreshed.Items {
+		old, ok := known[item.Key()]
+		switch {
+		case !ok:
+			result.Added = append(result.Added, item)
+		case changed(old, item):
+			result.Updated = append(result.Updated, item)
+		}
+	}
+	return result, nil
+}
+
+// changed reports whether the publisher edited the item since it was stored.
+func changed(old, current feed.Item) bool {
+	return old.Title != current.Title ||
+		old.Link != current.Link ||
+		old.Description != current.Description ||
+		old.Content != current.Content ||
+		old.Image != current.Image ||
+		!old.UpdatedAt.Equal(current.UpdatedAt) ||
+		!slices.Equal(old.Categories, current.Categories)
+}
@@ -0,0 +1,95 @@
+package refreshfeed_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+	feedRepo "rssreader/internal/infra/feed"
+	"rssreader/internal/usecase/refreshfeed"
+)
+
+type refresherStub struct {
+	store *feedRepo.MemoryStore
+	items []feed.Item
+	err   error
+	urls  []string
+}
+
+func (r *refresherStub) Refresh(ctx context.Context, url string) (*feed.Feed, error) {
+	r.urls = append(r.urls, url)
+	if r.err != nil {
+		return nil, r.err
+	}
+	refreshed := &feed.Feed{SourceURL: url, Title: "Example", Items: r.items}
+	if err := r.store.Save(ctx, refreshed); err != nil {
+		return nil, err
+	}
+	return refreshed, nil
+}
+
+func seed(t *testing.T) (*feedRepo.MemoryStore, int64) {
+	t.Helper()
+	store := feedRepo.NewMemoryStore()
+	entry := &feed.Feed{SourceURL: "https://example.com/rss", Title: "Example", Items: []feed.Item{
+		{GUID: "1", Title: "First"},
+		{GUID: "2", Title: "Second"},
+	}}
+	if err := store.Save(context.Background(), entry); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	recent, err := store.ListRecent(context.Background(), feed.RecentQuery{})
+	if err != nil || len(recent) != 1 {
+		t.Fatalf("list: %v", err)
+	}
+	return store, recent[0].ID
+}
+
+func TestExecuteReportsAddedAndUpdatedItems(t *testing.T) {
+	store, id := seed(t)
+	refresher := &refresherStub{store: store, items: []feed.Item{
+		{GUID: "3", Title: "Third"},
+		{GUID: "2", Title: "Second (edited)"},
+		{GUID: "1", Title: "First"},
+	}}
+
+	result, err := refreshfeed.New(store, refresher).Execute(context.Background(), id)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(refresher.urls) != 1 || refresher.urls[0] != "https://example.com/rss" {
+		t.Errorf("expected the stored url to be refreshed, got %v", refresher.urls)
+	}
+	if len(result.Added) != 1 || result.Added[0].Title != "Third" {
+		t.Errorf("expected one added item, got %+v", result.Added)
+	}
+	if len(result.Updated) != 1 || result.Updated[0].Title != "Second (edited)" {
+		t.Errorf("expected one updated item, got %+v", result.Updated)
+	}
+	if len(result.Feed.Items) != 3 {
+		t.Errorf("expected the refreshed feed to be returned, got %+v", result.Feed)
+	}
+}
+
+func TestExecuteReportsUnknownFeeds(t *testing.T) {
+	store, _ := seed(t)
+	uc := refreshfeed.New(store, &refresherStub{store: store})
+
+	if _, err := uc.Execute(context.Background(), 9999); !errors.Is(err, feed.ErrNotFound) {
+		t.Errorf("expected not found, got %v", err)
+	}
+	if _, err := uc.Execute(context.Background(), 0); !errors.Is(err, feed.ErrInvalidInput) {
+		t.Errorf("expected invalid input, got %v", err)
+	}
+}
+
+func TestExecutePropagatesRefreshErrors(t *testing.T) {
+	store, id := seed(t)
+	refresher := &refresherStub{store: store, err: feed.ErrUpstreamTimeout}
+
+	if _, err := refreshfeed.New(store, refresher).Execute(context.Background(), id); !errors.Is(err, feed.ErrUpstreamTimeout) {
+		t.Errorf("expected refresh error to be kept, got %v", err)
+	}
+}
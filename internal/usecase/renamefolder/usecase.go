@@ -0,0 +1,47 @@
+package renamefolder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// ErrNotFound is returned when the folder does not exist.
+var ErrNotFound = fmt.Errorf("folder %w", feed.ErrNotFound)
+
+// UseCase renames server-side folders.
+type UseCase struct {
+	store repository.FolderStore
+}
+
+// New constructs the use case with its dependencies.
+func New(store repository.FolderStore) *UseCase {
+	return &UseCase{store: store}
+}
+
+// Execute renames the folder and returns it.
+func (uc *UseCase) Execute(ctx context.Context, id int64, name string) (*feed.Folder, error) {
+	if uc.store == nil {
+		return nil, errors.New("folder store not configured")
+	}
+
+	name, err := feed.NormalizeFolderName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	folder, err := uc.store.RenameFolder(ctx, id, name)
+	if errors.Is(err, feed.ErrConflict) {
+		return nil, fmt.Errorf("folder %q %w", name, feed.ErrConflict)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("rename folder: %w: %w", feed.ErrStorage, err)
+	}
+	if folder == nil {
+		return nil, ErrNotFound
+	}
+	return folder, nil
+}
@@ -0,0 +1,45 @@
+package renamefolder_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+	feedRepo "rssreader/internal/infra/feed"
+	"rssreader/internal/usecase/renamefolder"
+)
+
+func TestExecuteRenamesFolder(t *testing.T) {
+	ctx := context.Background()
+	store := feedRepo.NewMemoryStore()
+	news, _ := store.CreateFolder(ctx, "News")
+	if _, err := store.CreateFolder(ctx, "Tech"); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	uc := renamefolder.New(store)
+
+	renamed, err := uc.Execute(ctx, news.ID, " Notícias ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if renamed.ID != news.ID || renamed.Name != "Notícias" {
+		t.Fatalf("unexpected renamed folder %+v", renamed)
+	}
+
+	if _, err := uc.Execute(ctx, news.ID, "TECH"); !errors.Is(err, feed.ErrConflict) {
+		t.Errorf("expected conflict for a taken name, got %v", err)
+	}
+	if _, err := uc.Execute(ctx, news.ID, " "); !errors.Is(err, feed.ErrInvalidInput) {
+		t.Errorf("expected invalid input for a blank name, got %v", err)
+	}
+	if _, err := uc.Execute(ctx, 999, "Other"); !errors.Is(err, feed.ErrNotFound) {
+		t.Errorf("expected not found for an unknown folder, got %v", err)
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	if _, err := renamefolder.New(nil).Execute(context.Background(), 1, "News"); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
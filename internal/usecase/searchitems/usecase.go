@@ -0,0 +1,65 @@
+package searchitems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+const (
+	defaultLimit   = 20
+	maxLimit       = 100
+	maxQueryLength = 256
+)
+
+// UseCase searches the stored articles.
+type UseCase struct {
+	store repository.SearchStore
+}
+
+// New constructs the use case with the required dependencies.
+func New(store repository.SearchStore) *UseCase {
+	return &UseCase{store: store}
+}
+
+// Execute validates the query and returns the matching items ordered by relevance.
+func (uc *UseCase) Execute(ctx context.Context, query feed.SearchQuery) ([]feed.SearchHit, error) {
+	if uc.store == nil {
+		return nil, errors.New("search store not configured")
+	}
+
+	query.Text = strings.TrimSpace(query.Text)
+	switch {
+	case query.Text == "":
+		return nil, fmt.Errorf("%w: search query is required", feed.ErrInvalidInput)
+	case utf8.RuneCountInString(query.Text) > maxQueryLength:
+		return nil, fmt.Errorf("%w: search query must be at most %d characters", feed.ErrInvalidInput, maxQueryLength)
+	}
+
+	if !query.From.IsZero() && !query.To.IsZero() && !query.From.Before(query.To) {
+		return nil, fmt.Errorf("%w: search date range is empty", feed.ErrInvalidInput)
+	}
+
+	query.FeedURL = strings.TrimSpace(query.FeedURL)
+	switch {
+	case query.Limit <= 0:
+		query.Limit = defaultLimit
+	case query.Limit > maxLimit:
+		query.Limit = maxLimit
+	}
+	if query.Offset < 0 {
+		query.Offset = 0
+	}
+
+	hits, err := uc.store.Search(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("search items: %w: %w", feed.ErrStorage, err)
+	}
+
+	return hits, nil
+}
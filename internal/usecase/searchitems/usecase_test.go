@@ -0,0 +1,88 @@
+package searchitems_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/usecase/searchitems"
+)
+
+type storeStub struct {
+	hits  []feed.SearchHit
+	err   error
+	query feed.SearchQuery
+	calls int
+}
+
+func (s *storeStub) Search(ctx context.Context, query feed.SearchQuery) ([]feed.SearchHit, error) {
+	s.calls++
+	s.query = query
+	return s.hits, s.err
+}
+
+func TestExecuteNormalizesQuery(t *testing.T) {
+	store := &storeStub{hits: []feed.SearchHit{{Item: feed.Item{ID: 1}, Rank: 0.5}}}
+
+	hits, err := searchitems.New(store).Execute(context.Background(), feed.SearchQuery{
+		Text:    "  eleições  ",
+		FeedURL: " https://g1.globo.com/rss/g1/ ",
+		Limit:   500,
+		Offset:  -1,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(hits) != 1 {
+		t.Fatalf("expected 1 hit, got %d", len(hits))
+	}
+	got := store.query
+	if got.Text != "eleições" || got.FeedURL != "https://g1.globo.com/rss/g1/" {
+		t.Errorf("expected trimmed query, got %+v", got)
+	}
+	if got.Limit != 100 || got.Offset != 0 {
+		t.Errorf("expected capped paging, got limit=%d offset=%d", got.Limit, got.Offset)
+	}
+}
+
+func TestExecuteValidatesQuery(t *testing.T) {
+	from := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name  string
+		query feed.SearchQuery
+	}{
+		{name: "empty text", query: feed.SearchQuery{Text: "   "}},
+		{name: "text too long", query: feed.SearchQuery{Text: strings.Repeat("a", 257)}},
+		{name: "inverted range", query: feed.SearchQuery{Text: "go", From: from, To: from.Add(-time.Hour)}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &storeStub{}
+			if _, err := searchitems.New(store).Execute(context.Background(), tc.query); err == nil {
+				t.Fatal("expected validation error")
+			}
+			if store.calls != 0 {
+				t.Fatal("expected store not to be called")
+			}
+		})
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	if _, err := searchitems.New(nil).Execute(context.Background(), feed.SearchQuery{Text: "go"}); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
+
+func TestExecutePropagatesStoreError(t *testing.T) {
+	uc := searchitems.New(&storeStub{err: errors.New("db error")})
+	if _, err := uc.Execute(context.Background(), feed.SearchQuery{Text: "go"}); err == nil {
+		t.Fatal("expected error when store fails")
+	}
+}
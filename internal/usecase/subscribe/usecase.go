@@ -0,0 +1,62 @@
+package subscribe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+const (
+	// DefaultInterval is used when no polling interval is provided.
+	DefaultInterval = 30 * time.Minute
+	// MinInterval protects publishers from overly aggressive polling.
+	MinInterval = time.Minute
+)
+
+// UseCase registers a feed URL to be polled in the background.
+type UseCase struct {
+	store repository.SubscriptionStore
+	clock func() time.Time
+}
+
+// New constructs the use case with its dependencies.
+func New(store repository.SubscriptionStore, clock func() time.Time) *UseCase {
+	if clock == nil {
+		clock = time.Now
+	}
+	return &UseCase{store: store, clock: clock}
+}
+
+// Execute subscribes to the feed URL, polling it every interval.
+func (uc *UseCase) Execute(ctx context.Context, rawURL string, interval time.Duration) (*feed.Subscription, error) {
+	if uc.store == nil {
+		return nil, errors.New("subscription store not configured")
+	}
+
+	trimmedURL, err := feed.NormalizeURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case interval == 0:
+		interval = DefaultInterval
+	case interval < MinInterval:
+		return nil, fmt.Errorf("%w: interval must be at least %s", feed.ErrInvalidInput, MinInterval)
+	}
+
+	entry := &feed.Subscription{
+		SourceURL:  trimmedURL,
+		Interval:   interval,
+		NextPollAt: uc.clock(),
+	}
+	if err := uc.store.Subscribe(ctx, entry); err != nil {
+		return nil, fmt.Errorf("subscribe: %w: %w", feed.ErrStorage, err)
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,102 @@
+package subscribe_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/usecase/subscribe"
+)
+
+type storeStub struct {
+	saved []*feed.Subscription
+	err   error
+}
+
+func (s *storeStub) Subscribe(ctx context.Context, entry *feed.Subscription) error {
+	if s.err != nil {
+		return s.err
+	}
+	entry.ID = int64(len(s.saved) + 1)
+	s.saved = append(s.saved, entry)
+	return nil
+}
+
+func (s *storeStub) Unsubscribe(ctx context.Context, url string) error {
+	return nil
+}
+
+func (s *storeStub) List(ctx context.Context) ([]feed.Subscription, error) {
+	return nil, nil
+}
+
+func (s *storeStub) ListDue(ctx context.Context, now time.Time, limit int) ([]feed.Subscription, error) {
+	return nil, nil
+}
+
+func (s *storeStub) MarkPolled(ctx context.Context, id int64, polledAt time.Time, pollErr error) error {
+	return nil
+}
+
+func TestExecuteSubscribesWithDefaultInterval(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := &storeStub{}
+	uc := subscribe.New(store, func() time.Time { return now })
+
+	sub, err := uc.Execute(context.Background(), "  https://example.com/rss ", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if sub.SourceURL != "https://example.com/rss" {
+		t.Errorf("unexpected source url: %q", sub.SourceURL)
+	}
+	if sub.Interval != subscribe.DefaultInterval {
+		t.Errorf("expected default interval, got %s", sub.Interval)
+	}
+	if !sub.NextPollAt.Equal(now) {
+		t.Errorf("expected first poll to be scheduled immediately, got %v", sub.NextPollAt)
+	}
+	if len(store.saved) != 1 {
+		t.Fatalf("expected store to be called once, got %d", len(store.saved))
+	}
+}
+
+func TestExecuteValidatesInput(t *testing.T) {
+	uc := subscribe.New(&storeStub{}, nil)
+
+	cases := []struct {
+		name     string
+		url      string
+		interval time.Duration
+	}{
+		{name: "empty url", url: " "},
+		{name: "relative url", url: "/rss.xml"},
+		{name: "unsupported scheme", url: "ftp://example.com/rss"},
+		{name: "interval too short", url: "https://example.com/rss", interval: time.Second},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := uc.Execute(context.Background(), tc.url, tc.interval); err == nil {
+				t.Fatal("expected validation error")
+			}
+		})
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	uc := subscribe.New(nil, nil)
+	if _, err := uc.Execute(context.Background(), "https://example.com/rss", 0); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
+
+func TestExecutePropagatesStoreError(t *testing.T) {
+	uc := subscribe.New(&storeStub{err: errors.New("db error")}, nil)
+	if _, err := uc.Execute(context.Background(), "https://example.com/rss", 0); err == nil {
+		t.Fatal("expected error when store fails")
+	}
+}
@@ -0,0 +1,45 @@
+package tagfeed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// ErrNotFound is returned when the feed does not exist.
+var ErrNotFound = fmt.Errorf("feed %w", feed.ErrNotFound)
+
+// UseCase labels stored feeds with free-form tags.
+type UseCase struct {
+	store repository.TagStore
+}
+
+// New constructs the use case with its dependencies.
+func New(store repository.TagStore) *UseCase {
+	return &UseCase{store: store}
+}
+
+// Execute replaces the tags of the feed and returns them normalized: lowercased,
+// sorted and without duplicates. An empty list removes every tag.
+func (uc *UseCase) Execute(ctx context.Context, feedID int64, tags []string) ([]string, error) {
+	if uc.store == nil {
+		return nil, errors.New("tag store not configured")
+	}
+
+	tags, err := feed.NormalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := uc.store.SetFeedTags(ctx, feedID, tags)
+	if err != nil {
+		return nil, fmt.Errorf("set feed tags: %w: %w", feed.ErrStorage, err)
+	}
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return tags, nil
+}
@@ -0,0 +1,70 @@
+package tagfeed_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+	feedRepo "rssreader/internal/infra/feed"
+	"rssreader/internal/usecase/tagfeed"
+)
+
+func TestExecuteNormalizesAndReplacesTags(t *testing.T) {
+	ctx := context.Background()
+	store := feedRepo.NewMemoryStore()
+	if err := store.Save(ctx, &feed.Feed{SourceURL: "https://example.com/rss"}); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	uc := tagfeed.New(store)
+
+	tags, err := uc.Execute(ctx, 1, []string{" Go ", "news", "go"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(tags, []string{"go", "news"}) {
+		t.Fatalf("expected normalized tags, got %v", tags)
+	}
+
+	if _, err := uc.Execute(ctx, 1, nil); err != nil {
+		t.Fatalf("clear tags: %v", err)
+	}
+	if counts, _ := store.ListTags(ctx); len(counts) != 0 {
+		t.Fatalf("expected tags to be removed, got %+v", counts)
+	}
+}
+
+func TestExecuteValidatesTags(t *testing.T) {
+	ctx := context.Background()
+	store := feedRepo.NewMemoryStore()
+	if err := store.Save(ctx, &feed.Feed{SourceURL: "https://example.com/rss"}); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	uc := tagfeed.New(store)
+
+	tooMany := make([]string, 0, feed.MaxFeedTags+1)
+	for i := range feed.MaxFeedTags + 1 {
+		tooMany = append(tooMany, strings.Repeat("t", i+1))
+	}
+	for name, tags := range map[string][]string{
+		"blank":    {"go", " "},
+		"too long": {strings.Repeat("x", feed.MaxTagLength+1)},
+		"too many": tooMany,
+	} {
+		if _, err := uc.Execute(ctx, 1, tags); !errors.Is(err, feed.ErrInvalidInput) {
+			t.Errorf("%s: expected invalid input, got %v", name, err)
+		}
+	}
+
+	if _, err := uc.Execute(ctx, 99, []string{"go"}); !errors.Is(err, feed.ErrNotFound) {
+		t.Errorf("expected not found for an unknown feed, got %v", err)
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	if _, err := tagfeed.New(nil).Execute(context.Background(), 1, nil); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
@@ -0,0 +1,43 @@
+package togglecatalogsource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// ErrNotFound is returned when no catalog source has the requested id.
+var ErrNotFound = fmt.Errorf("catalog source %w", feed.ErrNotFound)
+
+// UseCase hides catalog sources from readers or restores them.
+type UseCase struct {
+	store repository.CatalogStore
+}
+
+// New constructs the use case with its dependencies.
+func New(store repository.CatalogStore) *UseCase {
+	return &UseCase{store: store}
+}
+
+// Execute sets whether the source is disabled and returns it. Disabled sources stay
+// stored, so seeding the catalog again does not bring them back.
+func (uc *UseCase) Execute(ctx context.Context, id int64, disabled bool) (*feed.CatalogSource, error) {
+	if uc.store == nil {
+		return nil, errors.New("catalog store not configured")
+	}
+	if id <= 0 {
+		return nil, fmt.Errorf("%w: invalid catalog source id", feed.ErrInvalidInput)
+	}
+
+	source, err := uc.store.SetCatalogSourceDisabled(ctx, id, disabled)
+	if err != nil {
+		return nil, fmt.Errorf("update catalog source: %w: %w", feed.ErrStorage, err)
+	}
+	if source == nil {
+		return nil, ErrNotFound
+	}
+	return source, nil
+}
@@ -0,0 +1,44 @@
+package togglecatalogsource_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"rssreader/internal/domain/feed"
+	feedRepo "rssreader/internal/infra/feed"
+	"rssreader/internal/usecase/togglecatalogsource"
+)
+
+func TestExecuteTogglesSource(t *testing.T) {
+	ctx := context.Background()
+	store := feedRepo.NewMemoryCatalogStore()
+	err := store.SeedCatalog(ctx, []feed.CatalogCategory{
+		{ID: "noticias", Name: "Notícias", Sources: []feed.CatalogSource{{Name: "G1", URL: "https://g1.example/rss"}}},
+	})
+	if err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+	uc := togglecatalogsource.New(store)
+
+	source, err := uc.Execute(ctx, 1, true)
+	if err != nil || !source.Disabled {
+		t.Fatalf("expected the source to be disabled, got %+v %v", source, err)
+	}
+	if source, err = uc.Execute(ctx, 1, false); err != nil || source.Disabled {
+		t.Fatalf("expected the source to be restored, got %+v %v", source, err)
+	}
+
+	if _, err := uc.Execute(ctx, 99, true); !errors.Is(err, feed.ErrNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	if _, err := uc.Execute(ctx, 0, true); !errors.Is(err, feed.ErrInvalidInput) {
+		t.Fatalf("expected invalid input, got %v", err)
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	if _, err := togglecatalogsource.New(nil).Execute(context.Background(), 1, true); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
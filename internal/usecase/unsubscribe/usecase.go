@@ -0,0 +1,38 @@
+package unsubscribe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/repository"
+)
+
+// UseCase stops polling a subscribed feed.
+type UseCase struct {
+	store repository.SubscriptionStore
+}
+
+// New constructs the use case with its dependencies.
+func New(store repository.SubscriptionStore) *UseCase {
+	return &UseCase{store: store}
+}
+
+// Execute removes the subscription for the given URL.
+func (uc *UseCase) Execute(ctx context.Context, url string) error {
+	if uc.store == nil {
+		return errors.New("subscription store not configured")
+	}
+
+	trimmedURL := strings.TrimSpace(url)
+	if trimmedURL == "" {
+		return fmt.Errorf("%w: url is required", feed.ErrInvalidInput)
+	}
+
+	if err := uc.store.Unsubscribe(ctx, trimmedURL); err != nil {
+		return fmt.Errorf("unsubscribe: %w: %w", feed.ErrStorage, err)
+	}
+	return nil
+}
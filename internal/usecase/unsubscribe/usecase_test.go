@@ -0,0 +1,71 @@
+package unsubscribe_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"rssreader/internal/domain/feed"
+	"rssreader/internal/usecase/unsubscribe"
+)
+
+type storeStub struct {
+	removed []string
+	err     error
+}
+
+func (s *storeStub) Subscribe(ctx context.Context, entry *feed.Subscription) error {
+	return nil
+}
+
+func (s *storeStub) Unsubscribe(ctx context.Context, url string) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.removed = append(s.removed, url)
+	return nil
+}
+
+func (s *storeStub) List(ctx context.Context) ([]feed.Subscription, error) {
+	return nil, nil
+}
+
+func (s *storeStub) ListDue(ctx context.Context, now time.Time, limit int) ([]feed.Subscription, error) {
+	return nil, nil
+}
+
+func (s *storeStub) MarkPolled(ctx context.Context, id int64, polledAt time.Time, pollErr error) error {
+	return nil
+}
+
+func TestExecuteRemovesSubscription(t *testing.T) {
+	store := &storeStub{}
+	uc := unsubscribe.New(store)
+
+	if err := uc.Execute(context.Background(), " https://example.com/rss "); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(store.removed) != 1 || store.removed[0] != "https://example.com/rss" {
+		t.Fatalf("unexpected removals: %v", store.removed)
+	}
+}
+
+func TestExecuteValidatesURL(t *testing.T) {
+	if err := unsubscribe.New(&storeStub{}).Execute(context.Background(), ""); err == nil {
+		t.Fatal("expected error for empty URL")
+	}
+}
+
+func TestExecuteRequiresStore(t *testing.T) {
+	if err := unsubscribe.New(nil).Execute(context.Background(), "https://example.com/rss"); err == nil {
+		t.Fatal("expected error when store is nil")
+	}
+}
+
+func TestExecutePropagatesError(t *testing.T) {
+	uc := unsubscribe.New(&storeStub{err: errors.New("db error")})
+	if err := uc.Execute(context.Background(), "https://example.com/rss"); err == nil {
+		t.Fatal("expected error when store fails")
+	}
+}
@@ -1,30 +1,36 @@
-import { useCallback, useEffect, useState } from 'react';
+import { useCallback, useEffect, useRef, useState } from 'react';
 
+import { FeedCandidates } from './components/FeedCandidates';
 import { FeedCatalog } from './components/FeedCatalog';
 import { FeedForm } from './components/FeedForm';
 import { FeedItemsList } from './components/FeedItemsList';
 import { FeedSummary } from './components/FeedSummary';
 import { RecentFeeds } from './components/RecentFeeds';
 import { StatusBanner } from './components/StatusBanner';
-import { FEED_CATEGORIES, type FeedSource } from './data/sources';
+import { Timeline } from './components/Timeline';
+import { useCatalog } from './hooks/useCatalog';
+import { useDiscovery } from './hooks/useDiscovery';
 import { useFeed } from './hooks/useFeed';
 import { useRecentFeeds } from './hooks/useRecentFeeds';
+import type { CatalogCategory, CatalogSource } from './types/feed';
+import { formatRelativeTime } from './utils/relativeTime';
 
-const DEFAULT_CATEGORY = FEED_CATEGORIES[0];
-const DEFAULT_FEED = DEFAULT_CATEGORY?.feeds[0];
-const FALLBACK_URL = 'https://g1.globo.com/rss/g1/';
-const INITIAL_URL = DEFAULT_FEED?.url ?? FALLBACK_URL;
+const INITIAL_URL = 'https://g1.globo.com/rss/g1/';
+
+type View = 'feed' | 'timeline';
 
 const App = () => {
+  const [view, setView] = useState<View>('feed');
   const [url, setUrl] = useState(INITIAL_URL);
-  const [activeCategoryId, setActiveCategoryId] = useState(
-    DEFAULT_CATEGORY?.id ?? FEED_CATEGORIES[0]?.id ?? 'custom',
-  );
-  const [selectedCatalogFeed, setSelectedCatalogFeed] = useState<FeedSource | null>(
-    DEFAULT_FEED ?? null,
-  );
+  const [lastRequestedUrl, setLastRequestedUrl] = useState(INITIAL_URL);
+  const [activeCategoryId, setActiveCategoryId] = useState('');
+  const [selectedCatalogFeed, setSelectedCatalogFeed] = useState<CatalogSource | null>(null);
+  const { categories } = useCatalog();
+  // O catálogo chega depois do primeiro carregamento; a ref evita recarregar o feed.
+  const categoriesRef = useRef<CatalogCategory[]>(categories);
   const { feed, loading, error, lastUpdatedAt, fetchFeed, resetError } = useFeed();
-  const { recentFeeds, registerRecentFeed, clearRecentFeeds } = useRecentFeeds();
+  const { recentFeeds, registerRecentFeed, clearRecentFeeds, removeRecentFeed } = useRecentFeeds();
+  const { pageUrl, candidates, discover, resetDiscovery } = useDiscovery();
 
   const alignCatalogSelection = useCallback((targetUrl: string) => {
     const trimmed = targetUrl.trim();
@@ -33,8 +39,8 @@ const App = () => {
       return;
     }
 
-    for (const category of FEED_CATEGORIES) {
-      const found = category.feeds.find((feedSource) => feedSource.url === trimmed);
+    for (const category of categoriesRef.current) {
+      const found = category.sources.find((feedSource) => feedSource.url === trimmed);
       if (found) {
         setActiveCategoryId(category.id);
         setSelectedCatalogFeed(found);
@@ -52,7 +58,13 @@ const App = () => {
       }
 
       alignCatalogSelection(targetUrl);
-      const { data, fetchedAt } = await fetchFeed(targetUrl);
+      setLastRequestedUrl(targetUrl);
+      resetDiscovery();
+      const { data, fetchedAt, error: fetchError } = await fetchFeed(targetUrl);
+      if (fetchError?.code === 'unparseable_feed') {
+        // Provavelmente uma página HTML: procura os feeds que ela anuncia.
+        void discover(targetUrl);
+      }
       if (data && fetchedAt) {
         registerRecentFeed({
           sourceUrl: data.sourceUrl || targetUrl,
@@ -63,13 +75,18 @@ const App = () => {
         });
       }
     },
-    [alignCatalogSelection, fetchFeed, registerRecentFeed],
+    [alignCatalogSelection, discover, fetchFeed, registerRecentFeed, resetDiscovery],
   );
 
   useEffect(() => {
     void handleLoadFeed(INITIAL_URL);
   }, [handleLoadFeed]);
 
+  useEffect(() => {
+    categoriesRef.current = categories;
+    alignCatalogSelection(lastRequestedUrl);
+  }, [categories, alignCatalogSelection, lastRequestedUrl]);
+
   const handleInputChange = (value: string) => {
     if (error) {
       resetError();
@@ -87,17 +104,25 @@ const App = () => {
     void handleLoadFeed(selectedUrl);
   };
 
+  const handleSelectCandidate = (candidateUrl: string) => {
+    setUrl(candidateUrl);
+    void handleLoadFeed(candidateUrl);
+  };
+
   const handleCategorySelect = (categoryId: string) => {
     setActiveCategoryId(categoryId);
   };
 
-  const handleFeedSelect = (categoryId: string, feedSource: FeedSource) => {
+  const handleFeedSelect = (categoryId: string, feedSource: CatalogSource) => {
     setActiveCategoryId(categoryId);
     setSelectedCatalogFeed(feedSource);
     setUrl(feedSource.url);
     void handleLoadFeed(feedSource.url);
   };
 
+  // O servidor informa a idade da cópia em segundos; converte para "há 3 horas".
+  const staleSince = feed?.stale ? formatRelativeTime(new Date(Date.now() - (feed.age ?? 0) * 1000)) : '';
+
   return (
     <div className="app-container">
       <header className="page-header">
@@ -105,27 +130,68 @@ const App = () => {
         <p>Explore fontes brasileiras confiáveis, salve seus favoritos e acompanhe as últimas matérias.</p>
       </header>
 
-      <FeedCatalog
-        categories={FEED_CATEGORIES}
-        activeCategoryId={activeCategoryId}
-        selectedFeedUrl={selectedCatalogFeed?.url}
-        onCategorySelect={handleCategorySelect}
-        onFeedSelect={handleFeedSelect}
-      />
-
-      <FeedForm value={url} loading={loading} onChange={handleInputChange} onSubmit={handleSubmit} />
-
-      {error && <StatusBanner message={error} tone="error" onDismiss={resetError} />}
-
-      <RecentFeeds feeds={recentFeeds} onSelect={handleSelectRecent} onClear={clearRecentFeeds} />
-
-      {feed ? (
+      <nav className="view-switch" aria-label="Modo de leitura">
+        <button type="button" className={view === 'feed' ? 'is-active' : ''} onClick={() => setView('feed')}>
+          Feed individual
+        </button>
+        <button
+          type="button"
+          className={view === 'timeline' ? 'is-active' : ''}
+          onClick={() => setView('timeline')}
+        >
+          Linha do tempo
+        </button>
+      </nav>
+
+      {view === 'timeline' ? (
+        <Timeline />
+      ) : (
         <>
-          <FeedSummary feed={feed} lastUpdatedAt={lastUpdatedAt} />
-          <FeedItemsList items={feed.items} loading={loading} />
+          <FeedCatalog
+            categories={categories}
+            activeCategoryId={activeCategoryId}
+            selectedFeedUrl={selectedCatalogFeed?.url}
+            onCategorySelect={handleCategorySelect}
+            onFeedSelect={handleFeedSelect}
+          />
+
+          <FeedForm value={url} loading={loading} onChange={handleInputChange} onSubmit={handleSubmit} />
+
+          {error && (
+            <StatusBanner
+              message={error.message}
+              tone={error.tone}
+              onDismiss={resetError}
+              onRetry={error.retryable ? () => void handleLoadFeed(lastRequestedUrl) : undefined}
+            />
+          )}
+
+          {feed?.stale && !loading && (
+            <StatusBanner
+              message={`Não foi possível atualizar o feed agora; mostrando a cópia armazenada, atualizada ${staleSince}.`}
+              tone="warning"
+              onRetry={() => void handleLoadFeed(lastRequestedUrl)}
+            />
+          )}
+
+          {pageUrl && <FeedCandidates pageUrl={pageUrl} candidates={candidates} onSelect={handleSelectCandidate} />}
+
+          <RecentFeeds
+            feeds={recentFeeds}
+            onSelect={handleSelectRecent}
+            onClear={clearRecentFeeds}
+            onRemove={removeRecentFeed}
+          />
+
+          {feed ? (
+            <>
+              <FeedSummary feed={feed} lastUpdatedAt={lastUpdatedAt} />
+              <FeedItemsList items={feed.items} loading={loading} />
+            </>
+          ) : (
+            !loading && <p className="muted-text">Informe uma URL válida para carregar um feed.</p>
+          )}
         </>
-      ) : (
-        !loading && <p className="muted-text">Informe uma URL válida para carregar um feed.</p>
       )}
     </div>
   );
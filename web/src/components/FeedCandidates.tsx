@@ -0,0 +1,38 @@
+import type { FeedCandidate } from '../types/feed';
+
+type FeedCandidatesProps = {
+  pageUrl: string;
+  candidates: FeedCandidate[];
+  onSelect: (url: string) => void;
+};
+
+const FORMAT_LABELS: Record<FeedCandidate['format'], string> = {
+  rss: 'RSS',
+  atom: 'Atom',
+  json: 'JSON Feed',
+};
+
+export const FeedCandidates = ({ pageUrl, candidates, onSelect }: FeedCandidatesProps) => {
+  if (candidates.length === 0) {
+    return null;
+  }
+
+  return (
+    <section className="feed-candidates">
+      <h2>Feeds encontrados em {pageUrl}</h2>
+      <ul>
+        {candidates.map((candidate) => (
+          <li key={candidate.url}>
+            <button type="button" onClick={() => onSelect(candidate.url)}>
+              <span className="feed-candidates__title">
+                {candidate.title || candidate.url}
+                <span className="feed-candidates__format">{FORMAT_LABELS[candidate.format]}</span>
+              </span>
+              <span className="feed-candidates__url">{candidate.url}</span>
+            </button>
+          </li>
+        ))}
+      </ul>
+    </section>
+  );
+};
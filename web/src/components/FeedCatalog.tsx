@@ -1,11 +1,11 @@
-import type { FeedCategory, FeedSource } from '../data/sources';
+import type { CatalogCategory, CatalogSource } from '../types/feed';
 
 type FeedCatalogProps = {
-  categories: FeedCategory[];
+  categories: CatalogCategory[];
   activeCategoryId: string;
   selectedFeedUrl?: string | null;
   onCategorySelect: (categoryId: string) => void;
-  onFeedSelect: (categoryId: string, feed: FeedSource) => void;
+  onFeedSelect: (categoryId: string, feed: CatalogSource) => void;
 };
 
 export const FeedCatalog = ({
@@ -53,15 +53,20 @@ export const FeedCatalog = ({
       <p className="feed-catalog__description">{activeCategory.description}</p>
 
       <div className="feed-catalog__grid">
-        {activeCategory.feeds.map((feed) => {
+        {activeCategory.sources.map((feed) => {
           const isSelected = selectedFeedUrl === feed.url;
           return (
             <article
-              key={feed.url}
-              className={`feed-catalog__card ${isSelected ? 'is-selected' : ''}`}
+              key={feed.id}
+              className={`feed-catalog__card ${isSelected ? 'is-selected' : ''} ${feed.broken ? 'is-broken' : ''}`}
             >
               <h3>{feed.name}</h3>
               {feed.description && <p>{feed.description}</p>}
+              {feed.broken && (
+                <p className="feed-catalog__warning" title={feed.lastError}>
+                  Esta fonte falhou nas últimas verificações e pode estar fora do ar.
+                </p>
+              )}
               <button
                 type="button"
                 className="feed-catalog__cta"
@@ -48,12 +48,14 @@ export const FeedItemsList = ({ items, loading }: FeedItemsListProps) => {
   return (
     <ul className="feed-list">
       {items.map((item) => {
-        const sanitized = sanitizeHtml(item.description || '');
+        const sanitized = sanitizeHtml(item.content || item.description || '');
         const fallbackText = extractPlainText(sanitized);
         const hasHtml = sanitized && sanitized !== fallbackText;
+        const hasInlineImage = /<img\b/i.test(sanitized);
+        const audio = item.enclosures?.find((enclosure) => enclosure.type?.startsWith('audio/'));
 
         return (
-          <li className="feed-card" key={`${item.link}-${item.title}`}>
+          <li className="feed-card" key={item.id ?? `${item.link}-${item.title}`}>
             <time dateTime={item.publishedAt}>{formatPublished(item.publishedAt)}</time>
             <h3>
               <a href={item.link} target="_blank" rel="noreferrer">
@@ -61,6 +63,14 @@ export const FeedItemsList = ({ items, loading }: FeedItemsListProps) => {
               </a>
             </h3>
 
+            {item.authors && item.authors.length > 0 && (
+              <p className="feed-card__authors">Por {item.authors.join(', ')}</p>
+            )}
+
+            {item.image && !hasInlineImage && (
+              <img className="feed-card__image" src={item.image} alt="" loading="lazy" />
+            )}
+
             {hasHtml ? (
               <div
                 className="feed-card__content"
@@ -69,6 +79,16 @@ export const FeedItemsList = ({ items, loading }: FeedItemsListProps) => {
             ) : (
               fallbackText && <p>{fallbackText}</p>
             )}
+
+            {audio && <audio className="feed-card__audio" controls preload="none" src={audio.url} />}
+
+            {item.categories && item.categories.length > 0 && (
+              <ul className="feed-card__tags">
+                {item.categories.map((category) => (
+                  <li key={category}>{category}</li>
+                ))}
+              </ul>
+            )}
           </li>
         );
       })}
@@ -1,31 +1,19 @@
-import type { RecentFeed } from '../types/feed';
+import type { FeedHealth, RecentFeed } from '../types/feed';
+import { formatRelativeTime } from '../utils/relativeTime';
 
 type RecentFeedsProps = {
   feeds: RecentFeed[];
   onSelect: (url: string) => void;
   onClear: () => void | Promise<void>;
+  onRemove?: (feed: RecentFeed) => void | Promise<void>;
 };
 
-const formatRelativeTime = (value: string) => {
-  try {
-    const formatter = new Intl.RelativeTimeFormat('pt-BR', { numeric: 'auto' });
-    const diff = Date.now() - new Date(value).getTime();
-    const minutes = Math.round(diff / (60 * 1000));
-    if (Math.abs(minutes) < 60) {
-      return formatter.format(-minutes, 'minute');
-    }
-    const hours = Math.round(diff / (60 * 60 * 1000));
-    if (Math.abs(hours) < 24) {
-      return formatter.format(-hours, 'hour');
-    }
-    const days = Math.round(diff / (24 * 60 * 60 * 1000));
-    return formatter.format(-days, 'day');
-  } catch {
-    return '';
-  }
+const HEALTH_LABELS: Partial<Record<FeedHealth, string>> = {
+  degraded: 'Instável',
+  failing: 'Fora do ar',
 };
 
-export const RecentFeeds = ({ feeds, onSelect, onClear }: RecentFeedsProps) => {
+export const RecentFeeds = ({ feeds, onSelect, onClear, onRemove }: RecentFeedsProps) => {
   if (feeds.length === 0) {
     return null;
   }
@@ -42,12 +30,38 @@ export const RecentFeeds = ({ feeds, onSelect, onClear }: RecentFeedsProps) => {
         {feeds.map((item) => (
           <li key={item.sourceUrl}>
             <button type="button" onClick={() => onSelect(item.sourceUrl)}>
-              <span className="recent-feeds__title">{item.title || item.sourceUrl}</span>
+              <span className="recent-feeds__title">
+                {item.title || item.sourceUrl}
+                {item.unreadCount ? (
+                  <span className="recent-feeds__badge" aria-label={`${item.unreadCount} não lidos`}>
+                    {item.unreadCount}
+                  </span>
+                ) : null}
+                {item.health && HEALTH_LABELS[item.health] ? (
+                  <span
+                    className={`recent-feeds__health is-${item.health}`}
+                    title="Falhas recentes ao atualizar este feed"
+                  >
+                    {HEALTH_LABELS[item.health]}
+                  </span>
+                ) : null}
+              </span>
               <span className="recent-feeds__meta">
                 {item.sourceUrl}
                 {item.fetchedAt && <small> - {formatRelativeTime(item.fetchedAt)}</small>}
               </span>
             </button>
+            {onRemove && (
+              <button
+                type="button"
+                className="recent-feeds__remove"
+                aria-label={`Remover ${item.title || item.sourceUrl}`}
+                title="Remover feed e artigos armazenados"
+                onClick={() => void onRemove(item)}
+              >
+                ×
+              </button>
+            )}
           </li>
         ))}
       </ul>
@@ -1,24 +1,35 @@
-type StatusTone = 'info' | 'success' | 'error';
+import type { StatusTone } from '../utils/apiError';
 
 type StatusBannerProps = {
   message: string;
   tone?: StatusTone;
   onDismiss?: () => void;
+  onRetry?: () => void;
 };
 
-export const StatusBanner = ({ message, tone = 'info', onDismiss }: StatusBannerProps) => {
+export const StatusBanner = ({ message, tone = 'info', onDismiss, onRetry }: StatusBannerProps) => {
   if (!message) {
     return null;
   }
 
   return (
-    <div className={`status-banner status-banner--${tone}`} role={tone === 'error' ? 'alert' : 'status'}>
+    <div
+      className={`status-banner status-banner--${tone}`}
+      role={tone === 'error' || tone === 'warning' ? 'alert' : 'status'}
+    >
       <span>{message}</span>
-      {onDismiss && (
-        <button type="button" className="status-banner__dismiss" onClick={onDismiss} aria-label="Fechar aviso">
-          x
-        </button>
-      )}
+      <div className="status-banner__actions">
+        {onRetry && (
+          <button type="button" className="status-banner__retry" onClick={onRetry}>
+            Tentar novamente
+          </button>
+        )}
+        {onDismiss && (
+          <button type="button" className="status-banner__dismiss" onClick={onDismiss} aria-label="Fechar aviso">
+            x
+          </button>
+        )}
+      </div>
     </div>
   );
 };
@@ -0,0 +1,57 @@
+import { useEffect, useState } from 'react';
+
+import { useTimeline } from '../hooks/useTimeline';
+import type { TimelineState } from '../types/feed';
+import { FeedItemsList } from './FeedItemsList';
+import { StatusBanner } from './StatusBanner';
+
+const STATE_LABELS: Record<TimelineState, string> = {
+  all: 'Todos',
+  unread: 'Não lidos',
+  starred: 'Favoritos',
+};
+
+export const Timeline = () => {
+  const [state, setState] = useState<TimelineState>('all');
+  const { items, hasMore, loading, error, loadTimeline, loadMore, resetError } = useTimeline();
+
+  useEffect(() => {
+    void loadTimeline(state);
+  }, [loadTimeline, state]);
+
+  return (
+    <section className="timeline">
+      <div className="timeline__filters" role="tablist" aria-label="Filtrar linha do tempo">
+        {(Object.keys(STATE_LABELS) as TimelineState[]).map((option) => (
+          <button
+            key={option}
+            type="button"
+            role="tab"
+            aria-selected={state === option}
+            className={`timeline__filter${state === option ? ' is-active' : ''}`}
+            onClick={() => setState(option)}
+          >
+            {STATE_LABELS[option]}
+          </button>
+        ))}
+      </div>
+
+      {error && (
+        <StatusBanner
+          message={error.message}
+          tone={error.tone}
+          onDismiss={resetError}
+          onRetry={error.retryable ? () => void loadTimeline(state) : undefined}
+        />
+      )}
+
+      <FeedItemsList items={items} loading={loading && items.length === 0} />
+
+      {hasMore && (
+        <button type="button" className="timeline__more" disabled={loading} onClick={() => void loadMore()}>
+          {loading ? 'Carregando...' : 'Carregar mais'}
+        </button>
+      )}
+    </section>
+  );
+};
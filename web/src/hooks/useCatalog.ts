@@ -0,0 +1,35 @@
+import { useEffect, useState } from 'react';
+
+import type { CatalogCategory } from '../types/feed';
+
+type CatalogResponse = {
+  categories: CatalogCategory[];
+};
+
+export const useCatalog = () => {
+  const [categories, setCategories] = useState<CatalogCategory[]>([]);
+
+  useEffect(() => {
+    const controller = new AbortController();
+
+    const loadCatalog = async () => {
+      try {
+        const response = await fetch('/api/catalog', { signal: controller.signal });
+        if (!response.ok) {
+          throw new Error('Falha ao carregar o catálogo');
+        }
+        const payload = (await response.json()) as CatalogResponse;
+        if (Array.isArray(payload.categories)) {
+          setCategories(payload.categories.filter((category) => category.sources.length > 0));
+        }
+      } catch {
+        // Sem catálogo, o leitor continua aceitando URLs digitadas.
+      }
+    };
+
+    void loadCatalog();
+    return () => controller.abort();
+  }, []);
+
+  return { categories };
+};
@@ -0,0 +1,49 @@
+import { useCallback, useState } from 'react';
+
+import type { FeedCandidate } from '../types/feed';
+import { readApiError } from '../utils/apiError';
+
+type DiscoverResponse = {
+  candidates: FeedCandidate[];
+};
+
+export const useDiscovery = () => {
+  const [pageUrl, setPageUrl] = useState<string | null>(null);
+  const [candidates, setCandidates] = useState<FeedCandidate[]>([]);
+  const [discovering, setDiscovering] = useState(false);
+
+  const discover = useCallback(async (url: string): Promise<FeedCandidate[]> => {
+    setDiscovering(true);
+    setPageUrl(url);
+    setCandidates([]);
+
+    try {
+      const response = await fetch(`/api/discover?url=${encodeURIComponent(url)}`);
+      if (!response.ok) {
+        throw await readApiError(response, 'Falha ao procurar feeds na página');
+      }
+      const payload = (await response.json()) as DiscoverResponse;
+      const found = Array.isArray(payload.candidates) ? payload.candidates : [];
+      setCandidates(found);
+      return found;
+    } catch {
+      // Sem candidatos: o aviso de erro original continua visível.
+      return [];
+    } finally {
+      setDiscovering(false);
+    }
+  }, []);
+
+  const resetDiscovery = useCallback(() => {
+    setPageUrl(null);
+    setCandidates([]);
+  }, []);
+
+  return {
+    pageUrl,
+    candidates,
+    discovering,
+    discover,
+    resetDiscovery,
+  };
+};
@@ -1,16 +1,18 @@
 import { useCallback, useState } from 'react';
 
 import type { FeedResponse } from '../types/feed';
+import { describeError, readApiError, type ErrorNotice } from '../utils/apiError';
 
 type FetchResult = {
   data: FeedResponse | null;
   fetchedAt: string | null;
+  error: ErrorNotice | null;
 };
 
 export const useFeed = () => {
   const [feed, setFeed] = useState<FeedResponse | null>(null);
   const [loading, setLoading] = useState(false);
-  const [error, setError] = useState<string | null>(null);
+  const [error, setError] = useState<ErrorNotice | null>(null);
   const [lastUpdatedAt, setLastUpdatedAt] = useState<string | null>(null);
 
   const fetchFeed = useCallback(async (url: string): Promise<FetchResult> => {
@@ -19,28 +21,25 @@ export const useFeed = () => {
 
     try {
       const response = await fetch(`/api/feed?url=${encodeURIComponent(url)}`);
-      const payload = await response.json();
-
       if (!response.ok) {
-        const message = typeof payload?.error === 'string' ? payload.error : 'Erro ao carregar o feed';
-        throw new Error(message);
+        throw await readApiError(response, 'Erro ao carregar o feed');
       }
 
-      const data = payload as FeedResponse;
+      const data = (await response.json()) as FeedResponse;
       const timestamp = data.fetchedAt || new Date().toISOString();
 
       setFeed(data);
       setLastUpdatedAt(timestamp);
 
-      return { data, fetchedAt: timestamp };
+      return { data, fetchedAt: timestamp, error: null };
     } catch (err) {
       setFeed(null);
       setLastUpdatedAt(null);
 
-      const message = err instanceof Error ? err.message : 'Erro inesperado ao carregar o feed';
-      setError(message);
+      const notice = describeError(err, 'Erro inesperado ao carregar o feed');
+      setError(notice);
 
-      return { data: null, fetchedAt: null };
+      return { data: null, fetchedAt: null, error: notice };
     } finally {
       setLoading(false);
     }
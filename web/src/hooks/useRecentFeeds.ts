@@ -49,10 +49,24 @@ export const useRecentFeeds = () => {
     }
   }, []);
 
+  const removeRecentFeed = useCallback(async (recent: RecentFeed) => {
+    const ref = recent.id ? String(recent.id) : recent.sourceUrl;
+    try {
+      const response = await fetch(`/api/feeds/${encodeURIComponent(ref)}`, { method: 'DELETE' });
+      if (!response.ok && response.status !== 404) {
+        throw new Error('Falha ao remover feed');
+      }
+      setRecentFeeds((current) => current.filter((item) => item.sourceUrl !== recent.sourceUrl));
+    } catch {
+      // Mantém o feed na lista quando a remoção falha.
+    }
+  }, []);
+
   return {
     recentFeeds,
     registerRecentFeed,
     clearRecentFeeds,
+    removeRecentFeed,
     reload: loadFeeds,
   };
 };
@@ -0,0 +1,80 @@
+import { useCallback, useRef, useState } from 'react';
+
+import type { TimelineItem, TimelineState } from '../types/feed';
+import { describeError, readApiError, type ErrorNotice } from '../utils/apiError';
+
+const PAGE_SIZE = 30;
+
+type TimelineResponse = {
+  items: TimelineItem[];
+  nextCursor?: string;
+};
+
+export const useTimeline = () => {
+  const [items, setItems] = useState<TimelineItem[]>([]);
+  const [nextCursor, setNextCursor] = useState<string | null>(null);
+  const [loading, setLoading] = useState(false);
+  const [error, setError] = useState<ErrorNotice | null>(null);
+  const stateRef = useRef<TimelineState>('all');
+
+  const requestPage = useCallback(async (state: TimelineState, cursor?: string) => {
+    const params = new URLSearchParams({ state, limit: String(PAGE_SIZE) });
+    if (cursor) {
+      params.set('cursor', cursor);
+    }
+    const response = await fetch(`/api/items?${params.toString()}`);
+    if (!response.ok) {
+      throw await readApiError(response, 'Falha ao carregar a linha do tempo');
+    }
+    return (await response.json()) as TimelineResponse;
+  }, []);
+
+  const loadTimeline = useCallback(
+    async (state: TimelineState) => {
+      stateRef.current = state;
+      setLoading(true);
+      setError(null);
+
+      try {
+        const payload = await requestPage(state);
+        setItems(Array.isArray(payload.items) ? payload.items : []);
+        setNextCursor(payload.nextCursor ?? null);
+      } catch (err) {
+        setItems([]);
+        setNextCursor(null);
+        setError(describeError(err, 'Falha ao carregar a linha do tempo'));
+      } finally {
+        setLoading(false);
+      }
+    },
+    [requestPage],
+  );
+
+  const loadMore = useCallback(async () => {
+    if (!nextCursor) {
+      return;
+    }
+    setLoading(true);
+
+    try {
+      const payload = await requestPage(stateRef.current, nextCursor);
+      const page = Array.isArray(payload.items) ? payload.items : [];
+      setItems((current) => [...current, ...page]);
+      setNextCursor(payload.nextCursor ?? null);
+    } catch (err) {
+      setError(describeError(err, 'Falha ao carregar mais artigos'));
+    } finally {
+      setLoading(false);
+    }
+  }, [nextCursor, requestPage]);
+
+  return {
+    items,
+    hasMore: nextCursor !== null,
+    loading,
+    error,
+    loadTimeline,
+    loadMore,
+    resetError: () => setError(null),
+  };
+};
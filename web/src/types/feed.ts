@@ -1,8 +1,25 @@
+export type FeedEnclosure = {
+  url: string;
+  type?: string;
+  length?: number;
+};
+
 export type FeedItem = {
+  id?: number;
+  guid?: string;
   title: string;
   link: string;
   description: string;
+  content?: string;
+  authors?: string[];
+  categories?: string[];
+  image?: string;
+  enclosures?: FeedEnclosure[];
   publishedAt: string;
+  updatedAt?: string;
+  read?: boolean;
+  starred?: boolean;
+  archived?: boolean;
 };
 
 export type FeedResponse = {
@@ -10,14 +27,90 @@ export type FeedResponse = {
   title: string;
   description: string;
   link: string;
+  image?: string;
+  authors?: string[];
+  categories?: string[];
+  updatedAt?: string;
   fetchedAt: string;
+  checkedAt: string;
   items: FeedItem[];
+  // Presentes quando o download falhou e o servidor devolveu a cópia armazenada.
+  stale?: boolean;
+  upstreamError?: ApiErrorResponse;
+  // Segundos desde a última confirmação do publicador.
+  age?: number;
 };
 
 export type RecentFeed = {
+  id?: number;
   sourceUrl: string;
   title: string;
   description: string;
   link: string;
   fetchedAt: string;
+  unreadCount?: number;
+  folderId?: number;
+  tags?: string[];
+  health?: FeedHealth;
+};
+
+export type FeedHealth = 'healthy' | 'degraded' | 'failing';
+
+export type Folder = {
+  id: number;
+  name: string;
+  feedCount: number;
+  unreadCount: number;
+  createdAt: string;
+};
+
+export type CatalogSource = {
+  id: number;
+  categoryId: string;
+  name: string;
+  url: string;
+  description?: string;
+  disabled?: boolean;
+  broken: boolean;
+  lastError?: string;
+  checkedAt?: string;
+};
+
+export type CatalogCategory = {
+  id: string;
+  name: string;
+  description: string;
+  sources: CatalogSource[];
 };
+
+export type ApiErrorCode =
+  | 'invalid_input'
+  | 'not_found'
+  | 'conflict'
+  | 'unparseable_feed'
+  | 'upstream_status'
+  | 'upstream_unreachable'
+  | 'upstream_too_large'
+  | 'upstream_timeout'
+  | 'upstream_unavailable'
+  | 'storage_unavailable'
+  | 'not_supported'
+  | 'internal';
+
+export type ApiErrorResponse = {
+  error: string;
+  code: ApiErrorCode;
+  upstreamStatus?: number;
+};
+
+export type FeedCandidate = {
+  url: string;
+  title?: string;
+  format: 'rss' | 'atom' | 'json';
+};
+
+export type TimelineItem = FeedItem & {
+  feedUrl?: string;
+};
+
+export type TimelineState = 'all' | 'unread' | 'starred';
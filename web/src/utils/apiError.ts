@@ -0,0 +1,82 @@
+import type { ApiErrorCode, ApiErrorResponse } from '../types/feed';
+
+export type StatusTone = 'info' | 'success' | 'warning' | 'error';
+
+export class ApiError extends Error {
+  readonly code: ApiErrorCode;
+  readonly upstreamStatus?: number;
+
+  constructor(payload: ApiErrorResponse) {
+    super(payload.error);
+    this.name = 'ApiError';
+    this.code = payload.code;
+    this.upstreamStatus = payload.upstreamStatus;
+  }
+}
+
+export type ErrorNotice = {
+  message: string;
+  code?: ApiErrorCode;
+  tone: StatusTone;
+  retryable: boolean;
+};
+
+// Falhas transitórias do publicador ou do banco podem ser tentadas de novo.
+const RETRYABLE_CODES: ApiErrorCode[] = [
+  'upstream_unreachable',
+  'upstream_timeout',
+  'upstream_unavailable',
+  'storage_unavailable',
+];
+
+export const readApiError = async (response: Response, fallback: string): Promise<ApiError> => {
+  try {
+    const payload = (await response.json()) as Partial<ApiErrorResponse>;
+    if (typeof payload?.error === 'string') {
+      return new ApiError({
+        error: payload.error,
+        code: payload.code ?? 'internal',
+        upstreamStatus: payload.upstreamStatus,
+      });
+    }
+  } catch {
+    // Corpo sem JSON: usa a mensagem padrão.
+  }
+  return new ApiError({ error: fallback, code: 'internal' });
+};
+
+const describeApiError = (err: ApiError): Omit<ErrorNotice, 'code'> => {
+  const retryable = RETRYABLE_CODES.includes(err.code);
+  switch (err.code) {
+    case 'invalid_input':
+      return { message: `Verifique a URL informada: ${err.message}`, tone: 'warning', retryable };
+    case 'upstream_status':
+      return {
+        message: `O site respondeu com erro${err.upstreamStatus ? ` ${err.upstreamStatus}` : ''}. Confira se o endereço do feed está correto.`,
+        tone: 'error',
+        retryable,
+      };
+    case 'unparseable_feed':
+      return { message: 'O endereço não contém um feed RSS ou Atom válido.', tone: 'error', retryable };
+    case 'upstream_too_large':
+      return { message: 'O feed é grande demais para ser carregado.', tone: 'error', retryable };
+    case 'upstream_timeout':
+      return { message: 'O site demorou demais para responder. Tente novamente em instantes.', tone: 'warning', retryable };
+    case 'upstream_unreachable':
+    case 'upstream_unavailable':
+      return { message: 'Não foi possível contatar o site do feed agora. Tente novamente mais tarde.', tone: 'warning', retryable };
+    case 'storage_unavailable':
+      return { message: 'O armazenamento está indisponível no momento. Tente novamente.', tone: 'error', retryable };
+    default:
+      return { message: err.message, tone: 'error', retryable };
+  }
+};
+
+export const describeError = (err: unknown, fallback: string): ErrorNotice => {
+  if (!(err instanceof ApiError)) {
+    return { message: err instanceof Error ? err.message : fallback, tone: 'error', retryable: false };
+  }
+
+  const notice = describeApiError(err);
+  return { ...notice, code: err.code };
+};
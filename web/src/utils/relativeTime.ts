@@ -0,0 +1,19 @@
+// Descreve há quanto tempo a data ocorreu, por exemplo "há 3 horas".
+export const formatRelativeTime = (value: string | Date) => {
+  try {
+    const formatter = new Intl.RelativeTimeFormat('pt-BR', { numeric: 'auto' });
+    const diff = Date.now() - new Date(value).getTime();
+    const minutes = Math.round(diff / (60 * 1000));
+    if (Math.abs(minutes) < 60) {
+      return formatter.format(-minutes, 'minute');
+    }
+    const hours = Math.round(diff / (60 * 60 * 1000));
+    if (Math.abs(hours) < 24) {
+      return formatter.format(-hours, 'hour');
+    }
+    const days = Math.round(diff / (24 * 60 * 60 * 1000));
+    return formatter.format(-days, 'day');
+  } catch {
+    return '';
+  }
+};